@@ -11,8 +11,16 @@ type (
 	Limit = stmt.Limit
 	// Offset is an alias of stmt.Offset.
 	Offset = stmt.Offset
+	// LimitOffset is an alias of stmt.LimitOffset.
+	LimitOffset = stmt.LimitOffset
 	// String is an alias of stmt.String.
 	String = stmt.String
 	// Numeric is an alias of stmt.Numeric.
 	Numeric = stmt.Numeric
+	// Ident is an alias of stmt.Ident.
+	Ident = stmt.Ident
+	// Column is an alias of stmt.Column.
+	Column = stmt.Column
+	// Arg is an alias of stmt.Arg.
+	Arg = stmt.Arg
 )
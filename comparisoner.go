@@ -115,3 +115,49 @@ func NotIn(column string, args ...interface{}) *stmt.Condition {
 		},
 	}
 }
+
+// IsNull creates condition `column IS NULL`.
+func IsNull(column string) *stmt.Condition {
+	return &stmt.Condition{
+		Column: column,
+		Compare: &stmt.CompNull{
+			Negative: false,
+		},
+	}
+}
+
+// IsNotNull creates condition `column IS NOT NULL`.
+func IsNotNull(column string) *stmt.Condition {
+	return &stmt.Condition{
+		Column: column,
+		Compare: &stmt.CompNull{
+			Negative: true,
+		},
+	}
+}
+
+// IsDistinctFrom creates null-safe condition `column IS DISTINCT FROM ?`.
+// It is written for the dialect such as `NOT (column <=> ?)` on MySQL.
+// See also stmt.CompDistinct.
+func IsDistinctFrom(column string, value interface{}) *stmt.Condition {
+	return &stmt.Condition{
+		Column: column,
+		Compare: &stmt.CompDistinct{
+			Negative: false,
+			Value:    value,
+		},
+	}
+}
+
+// IsNotDistinctFrom creates null-safe condition `column IS NOT DISTINCT FROM ?`.
+// It is written for the dialect such as `column <=> ?` on MySQL.
+// See also stmt.CompDistinct.
+func IsNotDistinctFrom(column string, value interface{}) *stmt.Condition {
+	return &stmt.Condition{
+		Column: column,
+		Compare: &stmt.CompDistinct{
+			Negative: true,
+			Value:    value,
+		},
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -322,3 +324,109 @@ func TestNotBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNull(t *testing.T) {
+	tests := []struct {
+		expr *stmt.Condition
+		want string
+	}{
+		{
+			expr: sqb.IsNull("col"),
+			want: "col IS NULL",
+		},
+		{
+			expr: sqb.IsNotNull("col"),
+			want: "col IS NOT NULL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			b := &BuildCapture{
+				buf:  strings.Builder{},
+				Args: []interface{}{},
+			}
+			if err := tt.expr.Write(b); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := b.buf.String(); tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+			if len(b.Args) != 0 {
+				t.Errorf("args should be empty: %v", b.Args)
+			}
+		})
+	}
+}
+
+func TestIsDistinctFrom(t *testing.T) {
+	tests := []struct {
+		expr *stmt.Condition
+		want string
+	}{
+		{
+			expr: sqb.IsDistinctFrom("col", 1),
+			want: "NOT (col <=> ?)",
+		},
+		{
+			expr: sqb.IsNotDistinctFrom("col", 1),
+			want: "col <=> ?",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			got, args, err := sqb.New().Bind(tt.expr).Build("?")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+			if diff := cmp.Diff([]interface{}{1}, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+			if err := sqb.New().Bind(tt.expr).Validate(); err != nil {
+				t.Errorf("Builder.Validate() error = %v", err)
+			}
+		})
+	}
+}
+
+func TestOperand(t *testing.T) {
+	b := sqb.New().
+		Bind(sqb.Gt("a.updated_at", sqb.Column("b.synced_at"))).
+		Bind(sqb.Between("price", sqb.String("cost * 2"), 100))
+	got, args, err := b.Build("SELECT * FROM a JOIN b USING (id) WHERE ? AND ?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "SELECT * FROM a JOIN b USING (id) WHERE a.updated_at > b.synced_at AND price BETWEEN cost * 2 AND ?"
+	if want != got {
+		t.Errorf("\nwant: %q\ngot: %q", want, got)
+	}
+	if diff := cmp.Diff([]interface{}{100}, args); diff != "" {
+		t.Errorf("args (-want, +got)\n%s", diff)
+	}
+}
+
+func TestWithLeft(t *testing.T) {
+	eq := sqb.Eq("", "a@example.com")
+	b := sqb.New(sqb.SetDialect(dialect.PostgreSQL{})).
+		Bind(sqb.WithLeft(sqb.Func("LOWER", sqb.Column("email")), eq)).
+		Bind(sqb.WithLeft(sqb.Cast(sqb.Column("created_at"), "date"), sqb.Between("", "2020-01-01", "2020-12-31"))).
+		Bind(sqb.WithLeft(sqb.Arith("*", sqb.Column("price"), 2), sqb.Gt("", 100)))
+	got, args, err := b.Build("SELECT * FROM users WHERE ? AND ? AND ?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "SELECT * FROM users WHERE LOWER(email) = $1 AND (created_at)::date BETWEEN $2 AND $3 AND (price * $4) > $5"
+	if want != got {
+		t.Errorf("\nwant: %q\ngot: %q", want, got)
+	}
+	wantArgs := []interface{}{"a@example.com", "2020-01-01", "2020-12-31", 2, 100}
+	if diff := cmp.Diff(wantArgs, args); diff != "" {
+		t.Errorf("args (-want, +got)\n%s", diff)
+	}
+	if eq.Left != nil {
+		t.Errorf("WithLeft should not modify the original condition")
+	}
+}
@@ -103,3 +103,19 @@ func convertMapToStmts(f ConditionalFunc, m map[string]interface{}) []stmt.Expr
 	}
 	return exprs
 }
+
+// Not creates statement for the NOT boolean expression with parentheses.
+// If you want to know more details, See at stmt.Not.
+func Not(expr stmt.Expr) *stmt.Not {
+	return &stmt.Not{
+		Expr: expr,
+	}
+}
+
+// Negate returns the negated expression which pushes the negation down to
+// the conditions by De Morgan's laws, such as `a NOT IN (?, ?) OR b < ?`
+// for And(In("a", 1, 2), Ge("b", 3)).
+// If you want to know more details, See at stmt.Negate.
+func Negate(expr stmt.Expr) stmt.Expr {
+	return stmt.Negate(expr)
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
 	"github.com/Code-Hex/sqb/stmt"
 	"github.com/google/go-cmp/cmp"
 )
@@ -323,3 +324,40 @@ func TestParen(t *testing.T) {
 		})
 	}
 }
+
+func TestNot(t *testing.T) {
+	b := &BuildCapture{
+		buf:  strings.Builder{},
+		Args: []interface{}{},
+	}
+	expr := sqb.Not(sqb.Or(sqb.Eq("a", 1), sqb.Eq("b", 2)))
+	if err := expr.Write(b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want, got := "NOT (a = ? OR b = ?)", b.buf.String(); want != got {
+		t.Errorf("\nwant: %q\ngot: %q", want, got)
+	}
+	if diff := cmp.Diff([]interface{}{1, 2}, b.Args); diff != "" {
+		t.Errorf("args (-want, +got)\n%s", diff)
+	}
+}
+
+func TestNegate(t *testing.T) {
+	b := sqb.New(sqb.SetDialect(dialect.PostgreSQL{})).
+		Bind(sqb.Negate(sqb.And(
+			sqb.In("category", 1, 2),
+			sqb.Ge("price", 100),
+			sqb.Or(sqb.Like("name", "a%"), sqb.IsNull("deleted_at")),
+		)))
+	got, args, err := b.Build("SELECT * FROM items WHERE ?")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "SELECT * FROM items WHERE ((category NOT IN ($1, $2) OR price < $3) OR name NOT LIKE $4 AND deleted_at IS NOT NULL)"
+	if want != got {
+		t.Errorf("\nwant: %q\ngot: %q", want, got)
+	}
+	if diff := cmp.Diff([]interface{}{1, 2, 100, "a%"}, args); diff != "" {
+		t.Errorf("args (-want, +got)\n%s", diff)
+	}
+}
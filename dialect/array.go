@@ -0,0 +1,140 @@
+package dialect
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ArrayParam converts values into a single array parameter of the dialect.
+// It reports false if the dialect does not support array parameters.
+//
+// The dialect can support it by implementing
+// ArrayParam(values []interface{}) interface{} method. e.g. PostgreSQL.
+func ArrayParam(d Dialect, values []interface{}) (interface{}, bool) {
+	if d, ok := d.(interface {
+		ArrayParam(values []interface{}) interface{}
+	}); ok {
+		return d.ArrayParam(values), true
+	}
+	return nil, false
+}
+
+// ArrayParam returns values as PostgreSQLArray. See also dialect.ArrayParam.
+func (PostgreSQL) ArrayParam(values []interface{}) interface{} {
+	return PostgreSQLArray(values)
+}
+
+var _ driver.Valuer = PostgreSQLArray(nil)
+
+// PostgreSQLArray represents an array parameter of PostgreSQL such as
+// "col = ANY($1)".
+//
+// It implements driver.Valuer which returns the text representation of
+// the array like '{"1","2","3"}', so it can be used with any drivers.
+// The elements are cast to the element type of the column by PostgreSQL.
+type PostgreSQLArray []interface{}
+
+// Value implements driver.Valuer interface.
+func (a PostgreSQLArray) Value() (driver.Value, error) {
+	if a == nil {
+		return nil, nil
+	}
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, v := range a {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		if err := writeArrayElem(&b, v); err != nil {
+			return nil, fmt.Errorf("element[%d]: %w", i, err)
+		}
+	}
+	b.WriteByte('}')
+	return b.String(), nil
+}
+
+func writeArrayElem(b *strings.Builder, v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		b.WriteString("NULL")
+		return nil
+	case driver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			b.WriteString("NULL")
+			return nil
+		}
+		val, err := v.Value()
+		if err != nil {
+			return err
+		}
+		if _, ok := val.(driver.Valuer); ok {
+			return fmt.Errorf("%w: %T returns driver.Valuer", ErrUnsupportedLiteral, v)
+		}
+		return writeArrayElem(b, val)
+	case string:
+		writeArrayString(b, v)
+		return nil
+	case []byte:
+		if v == nil {
+			b.WriteString("NULL")
+			return nil
+		}
+		writeArrayString(b, `\x`+hex.EncodeToString(v))
+		return nil
+	case time.Time:
+		writeArrayString(b, v.Format(time.RFC3339Nano))
+		return nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			b.WriteString("NULL")
+			return nil
+		}
+		return writeArrayElem(b, rv.Elem().Interface())
+	case reflect.Bool:
+		if rv.Bool() {
+			b.WriteString("t")
+		} else {
+			b.WriteString("f")
+		}
+	case reflect.String:
+		writeArrayString(b, rv.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		b.WriteString(strconv.FormatInt(rv.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		b.WriteString(strconv.FormatUint(rv.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		switch f := rv.Float(); {
+		case math.IsInf(f, 1):
+			b.WriteString("Infinity")
+		case math.IsInf(f, -1):
+			b.WriteString("-Infinity")
+		default:
+			b.WriteString(strconv.FormatFloat(f, 'g', -1, rv.Type().Bits()))
+		}
+	default:
+		return fmt.Errorf("%w: %T", ErrUnsupportedLiteral, v)
+	}
+	return nil
+}
+
+// writeArrayString writes s as a double quoted element of the array.
+func writeArrayString(b *strings.Builder, s string) {
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; c == '"' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(s[i])
+	}
+	b.WriteByte('"')
+}
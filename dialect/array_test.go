@@ -0,0 +1,60 @@
+package dialect
+
+import (
+	"database/sql"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestPostgreSQLArray_Value(t *testing.T) {
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		array PostgreSQLArray
+		want  interface{}
+	}{
+		{name: "nil", array: nil, want: nil},
+		{name: "empty", array: PostgreSQLArray{}, want: "{}"},
+		{name: "ints", array: PostgreSQLArray{1, int64(-2), uint8(3)}, want: "{1,-2,3}"},
+		{name: "floats", array: PostgreSQLArray{1.5, math.Inf(1), math.Inf(-1)}, want: "{1.5,Infinity,-Infinity}"},
+		{name: "bools", array: PostgreSQLArray{true, false}, want: "{t,f}"},
+		{name: "strings", array: PostgreSQLArray{"a", `"b"`, `c\`, "d,e", "NULL"}, want: `{"a","\"b\"","c\\","d,e","NULL"}`},
+		{name: "null", array: PostgreSQLArray{nil, (*int)(nil), sql.NullInt64{}}, want: "{NULL,NULL,NULL}"},
+		{name: "bytes", array: PostgreSQLArray{[]byte("ab")}, want: `{"\\x6162"}`},
+		{name: "time", array: PostgreSQLArray{ts}, want: `{"2020-01-02T03:04:05Z"}`},
+		{name: "valuer", array: PostgreSQLArray{sql.NullString{String: "a", Valid: true}}, want: `{"a"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.array.Value()
+			if err != nil {
+				t.Fatalf("PostgreSQLArray.Value() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("PostgreSQLArray.Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := (PostgreSQLArray{struct{}{}}).Value(); !errors.Is(err, ErrUnsupportedLiteral) {
+		t.Errorf("PostgreSQLArray.Value() error = %v, want %v", err, ErrUnsupportedLiteral)
+	}
+}
+
+func TestArrayParam(t *testing.T) {
+	values := []interface{}{1, 2}
+	got, ok := ArrayParam(PostgreSQL{}, values)
+	if !ok {
+		t.Fatalf("ArrayParam() should report postgres supports array parameters")
+	}
+	if arr, ok := got.(PostgreSQLArray); !ok || len(arr) != 2 {
+		t.Errorf("ArrayParam() = %#v, want PostgreSQLArray", got)
+	}
+	for _, d := range []Dialect{MySQL{}, Spanner{}, SQLite{}, SQLServer{}, Oracle{}} {
+		if _, ok := ArrayParam(d, values); ok {
+			t.Errorf("ArrayParam() should report %s does not support array parameters", d.Name())
+		}
+	}
+}
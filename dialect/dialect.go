@@ -0,0 +1,160 @@
+// Package dialect provides SQL dialects of the databases.
+//
+// The dialect decides how to write database specific syntax such as
+// placeholders, quoted identifiers, literals and LIMIT/OFFSET clause.
+package dialect
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Dialect is the interface that represents SQL dialect of the database.
+type Dialect interface {
+	// Name returns the name of the dialect.
+	Name() string
+	// WritePlaceholder writes the n-th placeholder to w. n starts from 1.
+	WritePlaceholder(w io.StringWriter, n int)
+	// QuoteIdent returns the quoted identifier.
+	QuoteIdent(ident string) string
+	// Bool returns the literal of the boolean value.
+	Bool(v bool) string
+	// Null returns the literal of NULL.
+	Null() string
+	// LimitOffset returns the clause to limit rows. The negative value
+	// means it is not specified.
+	LimitOffset(limit, offset int64) string
+	// MaxParams returns the maximum number of parameters in a statement.
+	// Zero means unlimited.
+	MaxParams() int
+	// IsReserved reports whether word is a reserved word.
+	IsReserved(word string) bool
+}
+
+// EmptyStringIsNull reports whether the dialect treats an empty string as NULL.
+//
+// The dialect can report it by implementing EmptyStringIsNull() bool method.
+// e.g. Oracle.
+func EmptyStringIsNull(d Dialect) bool {
+	if d, ok := d.(interface{ EmptyStringIsNull() bool }); ok {
+		return d.EmptyStringIsNull()
+	}
+	return false
+}
+
+// CombinedLimitOffset reports whether the dialect requires the limit and
+// the offset to be written as a single clause, such as "OFFSET 5 ROWS FETCH
+// NEXT 10 ROWS ONLY" of SQL Server.
+//
+// The dialect can report it by implementing CombinedLimitOffset() bool method.
+// e.g. SQLServer and Oracle.
+func CombinedLimitOffset(d Dialect) bool {
+	if d, ok := d.(interface{ CombinedLimitOffset() bool }); ok {
+		return d.CombinedLimitOffset()
+	}
+	return false
+}
+
+// ParamName returns the name of the n-th parameter. It reports false if
+// the dialect does not use named parameters. n starts from 1.
+//
+// The dialect can report it by implementing ParamName(n int) string method.
+// The name does not contain the mark of placeholder such as '@' and ':'.
+// e.g. Spanner, SQLServer and Oracle.
+func ParamName(d Dialect, n int) (string, bool) {
+	if d, ok := d.(interface{ ParamName(n int) string }); ok {
+		return d.ParamName(n), true
+	}
+	return "", false
+}
+
+// Numbered reports whether the dialect uses numbered placeholders such as
+// '$1' and '@p1' which can reference the same argument more than once.
+//
+// The dialect can report it by implementing NumberedPlaceholders() bool
+// method. e.g. PostgreSQL, Spanner and SQLServer. Oracle reports false
+// because it binds the args to each occurrence of the placeholders.
+func Numbered(d Dialect) bool {
+	if d, ok := d.(interface{ NumberedPlaceholders() bool }); ok {
+		return d.NumberedPlaceholders()
+	}
+	return false
+}
+
+// Predicate returns the predicate which is always v, such as "1=1" and
+// "1=0". It can be used in WHERE clause unlike Bool.
+//
+// The dialect can customize it by implementing Predicate(v bool) string
+// method. e.g. MySQL, PostgreSQL and Spanner use TRUE and FALSE.
+func Predicate(d Dialect, v bool) string {
+	if d, ok := d.(interface{ Predicate(v bool) string }); ok {
+		return d.Predicate(v)
+	}
+	if v {
+		return "1=1"
+	}
+	return "1=0"
+}
+
+// writeNumbered writes the placeholder like "$1", "@1".
+func writeNumbered(w io.StringWriter, prefix string, n int) {
+	w.WriteString(prefix)
+	w.WriteString(strconv.Itoa(n))
+}
+
+// quote returns ident quoted by q. The q in ident is escaped by doubling.
+func quote(ident string, q string) string {
+	return q + strings.Replace(ident, q, q+q, -1) + q
+}
+
+// boolKeyword returns TRUE or FALSE.
+func boolKeyword(v bool) string {
+	if v {
+		return "TRUE"
+	}
+	return "FALSE"
+}
+
+// limitOffset returns "LIMIT <limit> OFFSET <offset>".
+func limitOffset(limit, offset int64) string {
+	var clause string
+	if limit >= 0 {
+		clause = "LIMIT " + strconv.FormatInt(limit, 10)
+	}
+	if offset >= 0 {
+		if clause != "" {
+			clause += " "
+		}
+		clause += "OFFSET " + strconv.FormatInt(offset, 10)
+	}
+	return clause
+}
+
+// keywords is a set of reserved words.
+type keywords map[string]struct{}
+
+func newKeywords(lists ...string) keywords {
+	ret := make(keywords)
+	for _, list := range lists {
+		for _, word := range strings.Fields(list) {
+			ret[word] = struct{}{}
+		}
+	}
+	return ret
+}
+
+func (k keywords) contains(word string) bool {
+	_, ok := k[strings.ToUpper(word)]
+	return ok
+}
+
+// standardKeywords is a set of reserved words which are reserved by
+// most of databases.
+const standardKeywords = `
+ALL AND AS ASC BETWEEN BY CASE CHECK COLUMN CONSTRAINT CREATE CROSS
+DEFAULT DELETE DESC DISTINCT DROP ELSE EXISTS FALSE FOR FOREIGN FROM
+GROUP HAVING IN INNER INSERT INTO IS JOIN LEFT LIKE LIMIT NOT NULL ON
+OR ORDER OUTER PRIMARY REFERENCES RIGHT SELECT SET TABLE THEN TO TRUE
+UNION UNIQUE UPDATE USING VALUES WHEN WHERE WITH
+`
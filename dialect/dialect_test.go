@@ -0,0 +1,287 @@
+package dialect
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDialects(t *testing.T) {
+	type want struct {
+		name         string
+		placeholders string
+		quoted       string
+		trueLit      string
+		falseLit     string
+		limit        string
+		offset       string
+		limitOffset  string
+		maxParams    int
+	}
+	tests := []struct {
+		dialect Dialect
+		want    want
+	}{
+		{
+			dialect: MySQL{},
+			want: want{
+				name:         "mysql",
+				placeholders: "?, ?, ?",
+				quoted:       "`my``col`",
+				trueLit:      "TRUE",
+				falseLit:     "FALSE",
+				limit:        "LIMIT 10",
+				offset:       "OFFSET 5",
+				limitOffset:  "LIMIT 10 OFFSET 5",
+				maxParams:    65535,
+			},
+		},
+		{
+			dialect: PostgreSQL{},
+			want: want{
+				name:         "postgres",
+				placeholders: "$1, $2, $3",
+				quoted:       "\"my`col\"",
+				trueLit:      "TRUE",
+				falseLit:     "FALSE",
+				limit:        "LIMIT 10",
+				offset:       "OFFSET 5",
+				limitOffset:  "LIMIT 10 OFFSET 5",
+				maxParams:    65535,
+			},
+		},
+		{
+			dialect: Spanner{},
+			want: want{
+				name:         "spanner",
+				placeholders: "@1, @2, @3",
+				quoted:       "`my``col`",
+				trueLit:      "TRUE",
+				falseLit:     "FALSE",
+				limit:        "LIMIT 10",
+				offset:       "OFFSET 5",
+				limitOffset:  "LIMIT 10 OFFSET 5",
+				maxParams:    950,
+			},
+		},
+		{
+			dialect: SQLite{},
+			want: want{
+				name:         "sqlite",
+				placeholders: "?, ?, ?",
+				quoted:       "\"my`col\"",
+				trueLit:      "1",
+				falseLit:     "0",
+				limit:        "LIMIT 10",
+				offset:       "LIMIT -1 OFFSET 5",
+				limitOffset:  "LIMIT 10 OFFSET 5",
+				maxParams:    999,
+			},
+		},
+		{
+			dialect: SQLite{Numbered: true, MaxVariables: 32766},
+			want: want{
+				name:         "sqlite",
+				placeholders: "?1, ?2, ?3",
+				quoted:       "\"my`col\"",
+				trueLit:      "1",
+				falseLit:     "0",
+				limit:        "LIMIT 10",
+				offset:       "LIMIT -1 OFFSET 5",
+				limitOffset:  "LIMIT 10 OFFSET 5",
+				maxParams:    32766,
+			},
+		},
+		{
+			dialect: SQLServer{},
+			want: want{
+				name:         "sqlserver",
+				placeholders: "@p1, @p2, @p3",
+				quoted:       "[my`col]",
+				trueLit:      "1",
+				falseLit:     "0",
+				limit:        "OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+				offset:       "OFFSET 5 ROWS",
+				limitOffset:  "OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY",
+				maxParams:    2100,
+			},
+		},
+		{
+			dialect: Oracle{},
+			want: want{
+				name:         "oracle",
+				placeholders: ":1, :2, :3",
+				quoted:       "\"my`col\"",
+				trueLit:      "1",
+				falseLit:     "0",
+				limit:        "FETCH FIRST 10 ROWS ONLY",
+				offset:       "OFFSET 5 ROWS",
+				limitOffset:  "OFFSET 5 ROWS FETCH FIRST 10 ROWS ONLY",
+				maxParams:    65535,
+			},
+		},
+	}
+	for _, tt := range tests {
+		d, want := tt.dialect, tt.want
+		t.Run(want.name, func(t *testing.T) {
+			if got := d.Name(); got != want.name {
+				t.Errorf("Name() = %q, want %q", got, want.name)
+			}
+			var buf strings.Builder
+			for i := 1; i <= 3; i++ {
+				if i > 1 {
+					buf.WriteString(", ")
+				}
+				d.WritePlaceholder(&buf, i)
+			}
+			if got := buf.String(); got != want.placeholders {
+				t.Errorf("WritePlaceholder() = %q, want %q", got, want.placeholders)
+			}
+			if got := d.QuoteIdent("my`col"); got != want.quoted {
+				t.Errorf("QuoteIdent() = %q, want %q", got, want.quoted)
+			}
+			if got := d.Bool(true); got != want.trueLit {
+				t.Errorf("Bool(true) = %q, want %q", got, want.trueLit)
+			}
+			if got := d.Bool(false); got != want.falseLit {
+				t.Errorf("Bool(false) = %q, want %q", got, want.falseLit)
+			}
+			if got := d.Null(); got != "NULL" {
+				t.Errorf("Null() = %q, want %q", got, "NULL")
+			}
+			if got := d.LimitOffset(10, -1); got != want.limit {
+				t.Errorf("LimitOffset(10, -1) = %q, want %q", got, want.limit)
+			}
+			if got := d.LimitOffset(-1, 5); got != want.offset {
+				t.Errorf("LimitOffset(-1, 5) = %q, want %q", got, want.offset)
+			}
+			if got := d.LimitOffset(10, 5); got != want.limitOffset {
+				t.Errorf("LimitOffset(10, 5) = %q, want %q", got, want.limitOffset)
+			}
+			if got := d.MaxParams(); got != want.maxParams {
+				t.Errorf("MaxParams() = %d, want %d", got, want.maxParams)
+			}
+			if !d.IsReserved("select") || !d.IsReserved("ORDER") {
+				t.Errorf("IsReserved() should report keywords are reserved")
+			}
+			if d.IsReserved("category") {
+				t.Errorf("IsReserved() should not report identifier is reserved")
+			}
+		})
+	}
+}
+
+func TestEmptyStringIsNull(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		want    bool
+	}{
+		{dialect: MySQL{}, want: false},
+		{dialect: PostgreSQL{}, want: false},
+		{dialect: Oracle{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect.Name(), func(t *testing.T) {
+			if got := EmptyStringIsNull(tt.dialect); got != tt.want {
+				t.Errorf("EmptyStringIsNull() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCombinedLimitOffset(t *testing.T) {
+	tests := []struct {
+		dialect Dialect
+		want    bool
+	}{
+		{dialect: MySQL{}, want: false},
+		{dialect: Oracle{}, want: true},
+		{dialect: SQLServer{}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect.Name(), func(t *testing.T) {
+			if got := CombinedLimitOffset(tt.dialect); got != tt.want {
+				t.Errorf("CombinedLimitOffset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParamName(t *testing.T) {
+	tests := []struct {
+		name        string
+		dialect     Dialect
+		placeholder string
+		want        string
+		wantOK      bool
+	}{
+		{name: "mysql", dialect: MySQL{}, placeholder: "?", want: "", wantOK: false},
+		{name: "postgres", dialect: PostgreSQL{}, placeholder: "$2", want: "", wantOK: false},
+		{name: "spanner", dialect: Spanner{}, placeholder: "@2", want: "2", wantOK: true},
+		{name: "spanner with prefix", dialect: Spanner{Prefix: "p"}, placeholder: "@p2", want: "p2", wantOK: true},
+		{name: "sqlserver", dialect: SQLServer{}, placeholder: "@p2", want: "p2", wantOK: true},
+		{name: "sqlserver with prefix", dialect: SQLServer{Prefix: "arg"}, placeholder: "@arg2", want: "arg2", wantOK: true},
+		{name: "oracle", dialect: Oracle{}, placeholder: ":2", want: "2", wantOK: true},
+		{name: "oracle with prefix", dialect: Oracle{Prefix: "v"}, placeholder: ":v2", want: "v2", wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var b strings.Builder
+			tt.dialect.WritePlaceholder(&b, 2)
+			if got := b.String(); got != tt.placeholder {
+				t.Errorf("WritePlaceholder() = %q, want %q", got, tt.placeholder)
+			}
+			got, ok := ParamName(tt.dialect, 2)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("ParamName() = (%q, %v), want (%q, %v)", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestNumbered(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		want    bool
+	}{
+		{name: "mysql", dialect: MySQL{}, want: false},
+		{name: "postgres", dialect: PostgreSQL{}, want: true},
+		{name: "spanner", dialect: Spanner{}, want: true},
+		{name: "sqlite", dialect: SQLite{}, want: false},
+		{name: "sqlite numbered", dialect: SQLite{Numbered: true}, want: true},
+		{name: "sqlserver", dialect: SQLServer{}, want: true},
+		{name: "oracle", dialect: Oracle{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Numbered(tt.dialect); got != tt.want {
+				t.Errorf("Numbered() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPredicate(t *testing.T) {
+	tests := []struct {
+		dialect   Dialect
+		wantTrue  string
+		wantFalse string
+	}{
+		{dialect: MySQL{}, wantTrue: "TRUE", wantFalse: "FALSE"},
+		{dialect: PostgreSQL{}, wantTrue: "TRUE", wantFalse: "FALSE"},
+		{dialect: Spanner{}, wantTrue: "TRUE", wantFalse: "FALSE"},
+		{dialect: SQLite{}, wantTrue: "1=1", wantFalse: "1=0"},
+		{dialect: SQLServer{}, wantTrue: "1=1", wantFalse: "1=0"},
+		{dialect: Oracle{}, wantTrue: "1=1", wantFalse: "1=0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dialect.Name(), func(t *testing.T) {
+			if got := Predicate(tt.dialect, true); got != tt.wantTrue {
+				t.Errorf("Predicate(true) = %q, want %q", got, tt.wantTrue)
+			}
+			if got := Predicate(tt.dialect, false); got != tt.wantFalse {
+				t.Errorf("Predicate(false) = %q, want %q", got, tt.wantFalse)
+			}
+		})
+	}
+}
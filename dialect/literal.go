@@ -0,0 +1,188 @@
+package dialect
+
+import (
+	"database/sql/driver"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ErrUnsupportedLiteral represents the value cannot be written as a literal
+// safely. It is returned by Literal.
+var ErrUnsupportedLiteral = errors.New("unsupported literal")
+
+// Literal returns v as an escaped literal of the dialect. The result is
+// intended to display the query such as logs. Do not execute it, use
+// placeholders instead.
+//
+// It supports nil, bool, string, []byte, time.Time, integers, floats,
+// pointers to them and driver.Valuer. The other types return an error which
+// wraps ErrUnsupportedLiteral. The dialect can customize it by implementing
+// Literal(v interface{}) (string, error) method.
+func Literal(d Dialect, v interface{}) (string, error) {
+	if l, ok := d.(interface {
+		Literal(v interface{}) (string, error)
+	}); ok {
+		return l.Literal(v)
+	}
+	return literalStyleOf(d).literal(d, v)
+}
+
+// literalStyle represents how the dialect writes literals.
+type literalStyle struct {
+	// backslash escapes the special characters in string literals by
+	// backslash instead of doubling quotes.
+	backslash bool
+	bytes     func(hex string) string
+	time      func(t time.Time) string
+}
+
+func literalStyleOf(d Dialect) literalStyle {
+	switch d.Name() {
+	case "mysql":
+		return literalStyle{
+			backslash: true,
+			bytes:     func(h string) string { return "X'" + h + "'" },
+			time: func(t time.Time) string {
+				return "'" + t.UTC().Format("2006-01-02 15:04:05.999999") + "'"
+			},
+		}
+	case "postgres":
+		return literalStyle{
+			bytes: func(h string) string { return `'\x` + h + "'::bytea" },
+			time: func(t time.Time) string {
+				return "'" + t.Format("2006-01-02 15:04:05.999999999Z07:00") + "'::timestamptz"
+			},
+		}
+	case "spanner":
+		return literalStyle{
+			backslash: true,
+			bytes:     func(h string) string { return "FROM_HEX('" + h + "')" },
+			time: func(t time.Time) string {
+				return "TIMESTAMP '" + t.Format(time.RFC3339Nano) + "'"
+			},
+		}
+	case "sqlite":
+		return literalStyle{
+			bytes: func(h string) string { return "X'" + h + "'" },
+			time: func(t time.Time) string {
+				return "'" + t.Format("2006-01-02 15:04:05.999999999-07:00") + "'"
+			},
+		}
+	case "sqlserver":
+		return literalStyle{
+			bytes: func(h string) string { return "0x" + h },
+			time: func(t time.Time) string {
+				return "'" + t.Format("2006-01-02T15:04:05.9999999-07:00") + "'"
+			},
+		}
+	case "oracle":
+		return literalStyle{
+			bytes: func(h string) string { return "HEXTORAW('" + h + "')" },
+			time: func(t time.Time) string {
+				return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05.999999999 -07:00") + "'"
+			},
+		}
+	default:
+		return literalStyle{
+			bytes: func(h string) string { return "X'" + h + "'" },
+			time: func(t time.Time) string {
+				return "TIMESTAMP '" + t.Format("2006-01-02 15:04:05.999999999-07:00") + "'"
+			},
+		}
+	}
+}
+
+func (s literalStyle) literal(d Dialect, v interface{}) (string, error) {
+	switch v := v.(type) {
+	case nil:
+		return d.Null(), nil
+	case driver.Valuer:
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr && rv.IsNil() {
+			return d.Null(), nil
+		}
+		val, err := v.Value()
+		if err != nil {
+			return "", err
+		}
+		if _, ok := val.(driver.Valuer); ok {
+			return "", fmt.Errorf("%w: %T returns driver.Valuer", ErrUnsupportedLiteral, v)
+		}
+		return s.literal(d, val)
+	case bool:
+		return d.Bool(v), nil
+	case string:
+		return s.string(v)
+	case []byte:
+		if v == nil {
+			return d.Null(), nil
+		}
+		return s.bytes(hex.EncodeToString(v)), nil
+	case time.Time:
+		return s.time(v), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		if rv.IsNil() {
+			return d.Null(), nil
+		}
+		return s.literal(d, rv.Elem().Interface())
+	case reflect.Bool:
+		return d.Bool(rv.Bool()), nil
+	case reflect.String:
+		return s.string(rv.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return "", fmt.Errorf("%w: %v", ErrUnsupportedLiteral, f)
+		}
+		return strconv.FormatFloat(f, 'g', -1, rv.Type().Bits()), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			return s.literal(d, rv.Bytes())
+		}
+	}
+	return "", ErrUnsupportedLiteral
+}
+
+func (s literalStyle) string(v string) (string, error) {
+	if !utf8.ValidString(v) {
+		return "", fmt.Errorf("%w: invalid UTF-8 string", ErrUnsupportedLiteral)
+	}
+	if strings.IndexByte(v, 0) >= 0 {
+		return "", fmt.Errorf("%w: string contains NUL", ErrUnsupportedLiteral)
+	}
+	if !s.backslash {
+		return "'" + strings.Replace(v, "'", "''", -1) + "'", nil
+	}
+	var b strings.Builder
+	b.Grow(len(v) + 2)
+	b.WriteByte('\'')
+	for i := 0; i < len(v); i++ {
+		switch c := v[i]; c {
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\'', '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('\'')
+	return b.String(), nil
+}
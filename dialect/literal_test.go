@@ -0,0 +1,99 @@
+package dialect
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+type status string
+
+type valuer struct{ v interface{} }
+
+func (v *valuer) Value() (driver.Value, error) { return v.v, nil }
+
+func TestLiteral(t *testing.T) {
+	jst := time.FixedZone("JST", 9*60*60)
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 600000000, jst)
+	str := "it's"
+	var nilValuer *valuer
+
+	tests := []struct {
+		name    string
+		dialect Dialect
+		value   interface{}
+		want    string
+	}{
+		{name: "nil", dialect: MySQL{}, value: nil, want: "NULL"},
+		{name: "bool mysql", dialect: MySQL{}, value: true, want: "TRUE"},
+		{name: "bool sqlserver", dialect: SQLServer{}, value: false, want: "0"},
+		{name: "int", dialect: PostgreSQL{}, value: -10, want: "-10"},
+		{name: "uint", dialect: PostgreSQL{}, value: uint8(10), want: "10"},
+		{name: "float", dialect: PostgreSQL{}, value: 1.5, want: "1.5"},
+		{name: "float32", dialect: PostgreSQL{}, value: float32(0.1), want: "0.1"},
+		{name: "string mysql", dialect: MySQL{}, value: "it's \\ \n", want: `'it\'s \\ \n'`},
+		{name: "string spanner", dialect: Spanner{}, value: "it's", want: `'it\'s'`},
+		{name: "string postgres", dialect: PostgreSQL{}, value: `it's \`, want: `'it''s \'`},
+		{name: "string oracle", dialect: Oracle{}, value: "it's", want: "'it''s'"},
+		{name: "named string", dialect: SQLite{}, value: status("ok"), want: "'ok'"},
+		{name: "pointer", dialect: SQLite{}, value: &str, want: "'it''s'"},
+		{name: "nil pointer", dialect: SQLite{}, value: (*string)(nil), want: "NULL"},
+		{name: "bytes mysql", dialect: MySQL{}, value: []byte("ab"), want: "X'6162'"},
+		{name: "bytes postgres", dialect: PostgreSQL{}, value: []byte("ab"), want: `'\x6162'::bytea`},
+		{name: "bytes spanner", dialect: Spanner{}, value: []byte("ab"), want: "FROM_HEX('6162')"},
+		{name: "bytes sqlite", dialect: SQLite{}, value: []byte("ab"), want: "X'6162'"},
+		{name: "bytes sqlserver", dialect: SQLServer{}, value: []byte("ab"), want: "0x6162"},
+		{name: "bytes oracle", dialect: Oracle{}, value: []byte("ab"), want: "HEXTORAW('6162')"},
+		{name: "nil bytes", dialect: MySQL{}, value: []byte(nil), want: "NULL"},
+		{name: "time mysql", dialect: MySQL{}, value: ts, want: "'2020-01-01 18:04:05.6'"},
+		{name: "time postgres", dialect: PostgreSQL{}, value: ts, want: "'2020-01-02 03:04:05.6+09:00'::timestamptz"},
+		{name: "time spanner", dialect: Spanner{}, value: ts, want: "TIMESTAMP '2020-01-02T03:04:05.6+09:00'"},
+		{name: "time sqlite", dialect: SQLite{}, value: ts, want: "'2020-01-02 03:04:05.6+09:00'"},
+		{name: "time sqlserver", dialect: SQLServer{}, value: ts, want: "'2020-01-02T03:04:05.6+09:00'"},
+		{name: "time oracle", dialect: Oracle{}, value: ts, want: "TIMESTAMP '2020-01-02 03:04:05.6 +09:00'"},
+		{name: "valuer", dialect: PostgreSQL{}, value: sql.NullString{String: "a", Valid: true}, want: "'a'"},
+		{name: "invalid valuer", dialect: PostgreSQL{}, value: sql.NullInt64{}, want: "NULL"},
+		{name: "pointer valuer", dialect: PostgreSQL{}, value: &valuer{v: int64(1)}, want: "1"},
+		{name: "nil pointer valuer", dialect: PostgreSQL{}, value: nilValuer, want: "NULL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Literal(tt.dialect, tt.value)
+			if err != nil {
+				t.Fatalf("Literal() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Literal() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLiteral_Unsupported(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+	}{
+		{name: "struct", value: struct{}{}},
+		{name: "slice", value: []int{1}},
+		{name: "map", value: map[string]int{}},
+		{name: "NaN", value: math.NaN()},
+		{name: "Inf", value: math.Inf(1)},
+		{name: "NUL", value: "a\x00b"},
+		{name: "invalid UTF-8", value: "\xff"},
+		{name: "valuer returns unsupported", value: &valuer{v: []int{1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, d := range []Dialect{MySQL{}, PostgreSQL{}} {
+				_, err := Literal(d, tt.value)
+				if !errors.Is(err, ErrUnsupportedLiteral) {
+					t.Errorf("Literal(%s) error = %v, want %v", d.Name(), err, ErrUnsupportedLiteral)
+				}
+			}
+		})
+	}
+}
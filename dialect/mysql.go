@@ -0,0 +1,64 @@
+package dialect
+
+import "io"
+
+var _ Dialect = MySQL{}
+
+// MySQL represents the dialect of MySQL.
+//
+// It uses '?' as a placeholder and backticks to quote identifiers.
+type MySQL struct{}
+
+// Name implements Dialect interface.
+func (MySQL) Name() string { return "mysql" }
+
+// WritePlaceholder implements Dialect interface.
+func (MySQL) WritePlaceholder(w io.StringWriter, n int) {
+	w.WriteString("?")
+}
+
+// QuoteIdent implements Dialect interface.
+func (MySQL) QuoteIdent(ident string) string {
+	return quote(ident, "`")
+}
+
+// Bool implements Dialect interface.
+func (MySQL) Bool(v bool) string { return boolKeyword(v) }
+
+// Predicate returns TRUE or FALSE. See also dialect.Predicate.
+func (MySQL) Predicate(v bool) string { return boolKeyword(v) }
+
+// Null implements Dialect interface.
+func (MySQL) Null() string { return "NULL" }
+
+// LimitOffset implements Dialect interface.
+func (MySQL) LimitOffset(limit, offset int64) string {
+	return limitOffset(limit, offset)
+}
+
+// MaxParams implements Dialect interface.
+func (MySQL) MaxParams() int { return 65535 }
+
+// IsReserved implements Dialect interface.
+func (MySQL) IsReserved(word string) bool {
+	return mysqlKeywords.contains(word)
+}
+
+var mysqlKeywords = newKeywords(standardKeywords, `
+ACCESSIBLE ADD ALTER ANALYZE BEFORE BIGINT BINARY BLOB BOTH CALL CASCADE
+CHANGE CHAR CHARACTER COLLATE CONDITION CONTINUE CONVERT CURRENT_DATE
+CURRENT_TIME CURRENT_TIMESTAMP CURRENT_USER CURSOR DATABASE DATABASES
+DAY_HOUR DAY_MICROSECOND DAY_MINUTE DAY_SECOND DEC DECIMAL DECLARE DELAYED
+DESCRIBE DETERMINISTIC DISTINCTROW DIV DOUBLE DUAL EACH ELSEIF ENCLOSED
+ESCAPED EXIT EXPLAIN FETCH FLOAT FORCE FULLTEXT FUNCTION GRANT GROUPS
+HIGH_PRIORITY IF IGNORE INDEX INFILE INOUT INT INTEGER INTERVAL ITERATE
+KEY KEYS KILL LEADING LEAVE LINEAR LINES LOAD LOCALTIME LOCALTIMESTAMP
+LOCK LONG LOOP LOW_PRIORITY MATCH MOD MODIFIES NATURAL NO_WRITE_TO_BINLOG
+NUMERIC OPTIMIZE OPTION OPTIONALLY OUT OUTFILE OVER PARTITION PRECISION
+PROCEDURE PURGE RANGE RANK READ READS REAL RECURSIVE REGEXP RELEASE
+RENAME REPEAT REPLACE REQUIRE RESIGNAL RESTRICT RETURN REVOKE RLIKE ROW
+ROWS SCHEMA SCHEMAS SEPARATOR SHOW SIGNAL SMALLINT SPATIAL SPECIFIC SQL
+STARTING STORED STRAIGHT_JOIN TERMINATED TINYINT TRAILING TRIGGER UNDO
+UNLOCK UNSIGNED USAGE USE UTC_DATE UTC_TIME UTC_TIMESTAMP VARBINARY
+VARCHAR VARYING VIRTUAL WHILE WINDOW WRITE XOR ZEROFILL
+`)
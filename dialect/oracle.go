@@ -0,0 +1,96 @@
+package dialect
+
+import (
+	"io"
+	"strconv"
+)
+
+var _ Dialect = Oracle{}
+
+// Oracle represents the dialect of Oracle Database.
+//
+// It uses ':1', ':2'... as placeholders and double quotes to quote identifiers.
+// The boolean values are written as 1 and 0. LIMIT and OFFSET are written as
+// "OFFSET <offset> ROWS FETCH FIRST <limit> ROWS ONLY".
+//
+// Oracle treats an empty string as NULL, so the comparison with an empty
+// string is written as "IS NULL". See also EmptyStringIsNull.
+type Oracle struct {
+	// Prefix is the prefix of the parameter names. e.g. If it is "p",
+	// placeholders are ':p1', ':p2'...
+	Prefix string
+}
+
+// Name implements Dialect interface.
+func (Oracle) Name() string { return "oracle" }
+
+// WritePlaceholder implements Dialect interface.
+func (o Oracle) WritePlaceholder(w io.StringWriter, n int) {
+	w.WriteString(":")
+	writeNumbered(w, o.Prefix, n)
+}
+
+// ParamName returns the name of the n-th parameter which is used as the name
+// of sql.NamedArg. See also dialect.ParamName.
+func (o Oracle) ParamName(n int) string {
+	return o.Prefix + strconv.Itoa(n)
+}
+
+// NumberedPlaceholders reports false. Although the placeholders are
+// numbered, Oracle binds the positional args to each occurrence of the
+// placeholders in SQL statements, so ':1' cannot be referenced twice.
+// See also dialect.Numbered.
+func (Oracle) NumberedPlaceholders() bool { return false }
+
+// QuoteIdent implements Dialect interface.
+func (Oracle) QuoteIdent(ident string) string {
+	return quote(ident, `"`)
+}
+
+// Bool implements Dialect interface.
+func (Oracle) Bool(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// Null implements Dialect interface.
+func (Oracle) Null() string { return "NULL" }
+
+// LimitOffset implements Dialect interface.
+func (Oracle) LimitOffset(limit, offset int64) string {
+	if offset < 0 {
+		if limit < 0 {
+			return ""
+		}
+		return "FETCH FIRST " + strconv.FormatInt(limit, 10) + " ROWS ONLY"
+	}
+	return offsetFetch(limit, offset, "FIRST")
+}
+
+// CombinedLimitOffset implements the interface which is used by
+// CombinedLimitOffset. OFFSET clause has to precede FETCH clause, so Limit
+// and Offset cannot be written in any order.
+func (Oracle) CombinedLimitOffset() bool { return true }
+
+// MaxParams implements Dialect interface.
+func (Oracle) MaxParams() int { return 65535 }
+
+// IsReserved implements Dialect interface.
+func (Oracle) IsReserved(word string) bool {
+	return oracleKeywords.contains(word)
+}
+
+// EmptyStringIsNull implements the interface which is used by EmptyStringIsNull.
+func (Oracle) EmptyStringIsNull() bool { return true }
+
+var oracleKeywords = newKeywords(standardKeywords, `
+ACCESS ADD ALTER ANY AUDIT CHAR CLUSTER COLUMN COMMENT COMPRESS CONNECT
+CURRENT DATE DECIMAL EXCLUSIVE FILE FLOAT GRANT IDENTIFIED IMMEDIATE
+INCREMENT INDEX INITIAL INTEGER INTERSECT LEVEL LOCK LONG MAXEXTENTS MINUS
+MLSLABEL MODE MODIFY NOAUDIT NOCOMPRESS NOWAIT NUMBER OF OFFLINE ONLINE
+OPTION PCTFREE PRIOR PUBLIC RAW RENAME RESOURCE REVOKE ROW ROWID ROWNUM
+ROWS SESSION SHARE SIZE SMALLINT START SUCCESSFUL SYNONYM SYSDATE TRIGGER
+UID USER VALIDATE VARCHAR VARCHAR2 VIEW WHENEVER
+`)
@@ -0,0 +1,60 @@
+package dialect
+
+import "io"
+
+var _ Dialect = PostgreSQL{}
+
+// PostgreSQL represents the dialect of PostgreSQL.
+//
+// It uses '$1', '$2'... as placeholders and double quotes to
+// quote identifiers.
+type PostgreSQL struct{}
+
+// Name implements Dialect interface.
+func (PostgreSQL) Name() string { return "postgres" }
+
+// WritePlaceholder implements Dialect interface.
+func (PostgreSQL) WritePlaceholder(w io.StringWriter, n int) {
+	writeNumbered(w, "$", n)
+}
+
+// NumberedPlaceholders reports true because it uses numbered placeholders.
+// See also dialect.Numbered.
+func (PostgreSQL) NumberedPlaceholders() bool { return true }
+
+// QuoteIdent implements Dialect interface.
+func (PostgreSQL) QuoteIdent(ident string) string {
+	return quote(ident, `"`)
+}
+
+// Bool implements Dialect interface.
+func (PostgreSQL) Bool(v bool) string { return boolKeyword(v) }
+
+// Predicate returns TRUE or FALSE. See also dialect.Predicate.
+func (PostgreSQL) Predicate(v bool) string { return boolKeyword(v) }
+
+// Null implements Dialect interface.
+func (PostgreSQL) Null() string { return "NULL" }
+
+// LimitOffset implements Dialect interface.
+func (PostgreSQL) LimitOffset(limit, offset int64) string {
+	return limitOffset(limit, offset)
+}
+
+// MaxParams implements Dialect interface.
+func (PostgreSQL) MaxParams() int { return 65535 }
+
+// IsReserved implements Dialect interface.
+func (PostgreSQL) IsReserved(word string) bool {
+	return postgresKeywords.contains(word)
+}
+
+var postgresKeywords = newKeywords(standardKeywords, `
+ANALYSE ANALYZE ANY ARRAY ASYMMETRIC AUTHORIZATION BINARY BOTH CAST
+COLLATE COLLATION CONCURRENTLY CURRENT_CATALOG CURRENT_DATE CURRENT_ROLE
+CURRENT_SCHEMA CURRENT_TIME CURRENT_TIMESTAMP CURRENT_USER DEFERRABLE DO
+END EXCEPT FETCH FREEZE FULL GRANT ILIKE INITIALLY INTERSECT ISNULL
+LATERAL LEADING LOCALTIME LOCALTIMESTAMP NATURAL NOTNULL OFFSET ONLY
+OVERLAPS PLACING RETURNING SESSION_USER SIMILAR SOME SYMMETRIC
+TABLESAMPLE TRAILING USER VARIADIC VERBOSE WINDOW
+`)
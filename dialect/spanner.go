@@ -0,0 +1,72 @@
+package dialect
+
+import (
+	"io"
+	"strconv"
+)
+
+var _ Dialect = Spanner{}
+
+// Spanner represents the dialect of Cloud Spanner.
+//
+// It uses '@1', '@2'... as placeholders and backticks to quote identifiers.
+type Spanner struct {
+	// Prefix is the prefix of the parameter names. e.g. If it is "p",
+	// placeholders are '@p1', '@p2'... Cloud Spanner requires the names
+	// to start with a letter or an underscore.
+	Prefix string
+}
+
+// Name implements Dialect interface.
+func (Spanner) Name() string { return "spanner" }
+
+// WritePlaceholder implements Dialect interface.
+func (s Spanner) WritePlaceholder(w io.StringWriter, n int) {
+	w.WriteString("@")
+	writeNumbered(w, s.Prefix, n)
+}
+
+// ParamName returns the name of the n-th parameter which is used as the key
+// of spanner.Statement.Params. See also dialect.ParamName.
+func (s Spanner) ParamName(n int) string {
+	return s.Prefix + strconv.Itoa(n)
+}
+
+// NumberedPlaceholders reports true because it uses numbered placeholders.
+// See also dialect.Numbered.
+func (Spanner) NumberedPlaceholders() bool { return true }
+
+// QuoteIdent implements Dialect interface.
+func (Spanner) QuoteIdent(ident string) string {
+	return quote(ident, "`")
+}
+
+// Bool implements Dialect interface.
+func (Spanner) Bool(v bool) string { return boolKeyword(v) }
+
+// Predicate returns TRUE or FALSE. See also dialect.Predicate.
+func (Spanner) Predicate(v bool) string { return boolKeyword(v) }
+
+// Null implements Dialect interface.
+func (Spanner) Null() string { return "NULL" }
+
+// LimitOffset implements Dialect interface.
+func (Spanner) LimitOffset(limit, offset int64) string {
+	return limitOffset(limit, offset)
+}
+
+// MaxParams implements Dialect interface.
+func (Spanner) MaxParams() int { return 950 }
+
+// IsReserved implements Dialect interface.
+func (Spanner) IsReserved(word string) bool {
+	return spannerKeywords.contains(word)
+}
+
+var spannerKeywords = newKeywords(standardKeywords, `
+ANY ARRAY ASSERT_ROWS_MODIFIED AT COLLATE CONTAINS CUBE CURRENT DEFINE
+END ENUM ESCAPE EXCEPT EXCLUDE EXTRACT FETCH FOLLOWING FULL GROUPING
+GROUPS HASH IF IGNORE INTERSECT INTERVAL LATERAL LOOKUP MERGE NATURAL NEW
+NO NULLS OF OVER PARTITION PRECEDING PROTO RANGE RECURSIVE RESPECT ROLLUP
+ROWS SOME STRUCT TABLESAMPLE TREAT UNBOUNDED UNNEST WINDOW WITHIN
+`)
@@ -0,0 +1,90 @@
+package dialect
+
+import (
+	"io"
+	"strconv"
+)
+
+var _ Dialect = SQLite{}
+
+// SQLite represents the dialect of SQLite.
+//
+// It uses '?' as a placeholder and double quotes to quote identifiers.
+// The boolean values are written as 1 and 0.
+type SQLite struct {
+	// Numbered uses '?1', '?2'... as placeholders instead of '?'.
+	// The numbered placeholder can reference the same argument more than once.
+	Numbered bool
+	// MaxVariables is the maximum number of variables in a statement which is
+	// decided by SQLITE_MAX_VARIABLE_NUMBER. If it is zero, 999 is used which
+	// is the default value for SQLite versions prior to 3.32.0. SQLite 3.32.0
+	// or later uses 32766 by default.
+	MaxVariables int
+}
+
+// Name implements Dialect interface.
+func (SQLite) Name() string { return "sqlite" }
+
+// WritePlaceholder implements Dialect interface.
+func (s SQLite) WritePlaceholder(w io.StringWriter, n int) {
+	if s.Numbered {
+		writeNumbered(w, "?", n)
+		return
+	}
+	w.WriteString("?")
+}
+
+// NumberedPlaceholders reports whether Numbered is enabled.
+// See also dialect.Numbered.
+func (s SQLite) NumberedPlaceholders() bool { return s.Numbered }
+
+// QuoteIdent implements Dialect interface.
+func (SQLite) QuoteIdent(ident string) string {
+	return quote(ident, `"`)
+}
+
+// Bool implements Dialect interface.
+func (SQLite) Bool(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// Null implements Dialect interface.
+func (SQLite) Null() string { return "NULL" }
+
+// LimitOffset implements Dialect interface.
+func (SQLite) LimitOffset(limit, offset int64) string {
+	// SQLite requires LIMIT clause to use OFFSET clause.
+	if limit < 0 && offset >= 0 {
+		return "LIMIT -1 OFFSET " + strconv.FormatInt(offset, 10)
+	}
+	return limitOffset(limit, offset)
+}
+
+// MaxParams implements Dialect interface.
+func (s SQLite) MaxParams() int {
+	if s.MaxVariables > 0 {
+		return s.MaxVariables
+	}
+	return 999
+}
+
+// IsReserved implements Dialect interface.
+func (SQLite) IsReserved(word string) bool {
+	return sqliteKeywords.contains(word)
+}
+
+var sqliteKeywords = newKeywords(standardKeywords, `
+ABORT ACTION ADD AFTER ALTER ALWAYS ANALYZE ATTACH AUTOINCREMENT BEFORE
+BEGIN CASCADE CAST COLLATE COMMIT CONFLICT CURRENT CURRENT_DATE
+CURRENT_TIME CURRENT_TIMESTAMP DATABASE DEFERRABLE DEFERRED DETACH DO
+EACH END ESCAPE EXCEPT EXCLUDE EXCLUSIVE EXPLAIN FAIL FILTER FIRST
+FOLLOWING FULL GENERATED GLOB GROUPS IF IGNORE IMMEDIATE INDEX INDEXED
+INITIALLY INSTEAD INTERSECT ISNULL KEY LAST MATCH MATERIALIZED NATURAL
+NO NOTHING NOTNULL NULLS OF OFFSET OTHERS OVER PARTITION PLAN PRAGMA
+PRECEDING QUERY RAISE RANGE RECURSIVE REGEXP REINDEX RELEASE RENAME
+REPLACE RESTRICT RETURNING ROLLBACK ROW ROWS SAVEPOINT TEMP TEMPORARY
+TIES TRANSACTION TRIGGER UNBOUNDED VACUUM VIEW VIRTUAL WINDOW WITHOUT
+`)
@@ -0,0 +1,116 @@
+package dialect
+
+import (
+	"io"
+	"strconv"
+	"strings"
+)
+
+var _ Dialect = SQLServer{}
+
+// SQLServer represents the dialect of Microsoft SQL Server.
+//
+// It uses '@p1', '@p2'... as placeholders and brackets to quote identifiers.
+// The boolean values are written as 1 and 0. LIMIT and OFFSET are written as
+// "OFFSET <offset> ROWS FETCH NEXT <limit> ROWS ONLY". Note that SQL Server
+// requires ORDER BY clause to use it.
+type SQLServer struct {
+	// Prefix is the prefix of the parameter names. If it is empty,
+	// "p" is used. e.g. '@p1', '@p2'...
+	Prefix string
+}
+
+// Name implements Dialect interface.
+func (SQLServer) Name() string { return "sqlserver" }
+
+// WritePlaceholder implements Dialect interface.
+func (s SQLServer) WritePlaceholder(w io.StringWriter, n int) {
+	w.WriteString("@")
+	writeNumbered(w, s.prefix(), n)
+}
+
+// ParamName returns the name of the n-th parameter which is used as the name
+// of sql.NamedArg. See also dialect.ParamName.
+func (s SQLServer) ParamName(n int) string {
+	return s.prefix() + strconv.Itoa(n)
+}
+
+func (s SQLServer) prefix() string {
+	if s.Prefix == "" {
+		return "p"
+	}
+	return s.Prefix
+}
+
+// NumberedPlaceholders reports true because it uses numbered placeholders.
+// See also dialect.Numbered.
+func (SQLServer) NumberedPlaceholders() bool { return true }
+
+// QuoteIdent implements Dialect interface.
+func (SQLServer) QuoteIdent(ident string) string {
+	return "[" + strings.Replace(ident, "]", "]]", -1) + "]"
+}
+
+// Bool implements Dialect interface.
+func (SQLServer) Bool(v bool) string {
+	if v {
+		return "1"
+	}
+	return "0"
+}
+
+// Null implements Dialect interface.
+func (SQLServer) Null() string { return "NULL" }
+
+// LimitOffset implements Dialect interface.
+func (SQLServer) LimitOffset(limit, offset int64) string {
+	return offsetFetch(limit, offset, "NEXT")
+}
+
+// CombinedLimitOffset implements the interface which is used by
+// CombinedLimitOffset. FETCH clause requires OFFSET clause, so Limit and
+// Offset cannot be written separately.
+func (SQLServer) CombinedLimitOffset() bool { return true }
+
+// MaxParams implements Dialect interface.
+func (SQLServer) MaxParams() int { return 2100 }
+
+// IsReserved implements Dialect interface.
+func (SQLServer) IsReserved(word string) bool {
+	return sqlserverKeywords.contains(word)
+}
+
+// offsetFetch returns "OFFSET <offset> ROWS FETCH <next> <limit> ROWS ONLY".
+// FETCH clause requires OFFSET clause, so "OFFSET 0 ROWS" is written if
+// the offset is not specified.
+func offsetFetch(limit, offset int64, next string) string {
+	if limit < 0 && offset < 0 {
+		return ""
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	clause := "OFFSET " + strconv.FormatInt(offset, 10) + " ROWS"
+	if limit >= 0 {
+		clause += " FETCH " + next + " " + strconv.FormatInt(limit, 10) + " ROWS ONLY"
+	}
+	return clause
+}
+
+var sqlserverKeywords = newKeywords(standardKeywords, `
+ADD ALTER ANY AUTHORIZATION BACKUP BEGIN BREAK BROWSE BULK CASCADE CHECKPOINT
+CLOSE CLUSTERED COALESCE COLLATE COMMIT COMPUTE CONTAINS CONTAINSTABLE
+CONTINUE CONVERT CURRENT CURRENT_DATE CURRENT_TIME CURRENT_TIMESTAMP
+CURRENT_USER CURSOR DATABASE DBCC DEALLOCATE DECLARE DENY DISK DISTRIBUTED
+DOUBLE DUMP END ERRLVL ESCAPE EXCEPT EXEC EXECUTE EXIT EXTERNAL FETCH FILE
+FILLFACTOR FREETEXT FREETEXTTABLE FULL FUNCTION GOTO GRANT HOLDLOCK
+IDENTITY IDENTITY_INSERT IDENTITYCOL IF INDEX INTERSECT KEY KILL LINENO
+LOAD MERGE NATIONAL NOCHECK NONCLUSTERED NULLIF OF OFF OFFSETS OPEN
+OPENDATASOURCE OPENQUERY OPENROWSET OPENXML OPTION OVER PERCENT PIVOT PLAN
+PRECISION PRINT PROC PROCEDURE PUBLIC RAISERROR READ READTEXT RECONFIGURE
+REPLICATION RESTORE RESTRICT RETURN REVERT REVOKE ROLLBACK ROWCOUNT
+ROWGUIDCOL RULE SAVE SCHEMA SECURITYAUDIT SEMANTICKEYPHRASETABLE
+SESSION_USER SETUSER SHUTDOWN SOME STATISTICS SYSTEM_USER TABLESAMPLE
+TEXTSIZE TOP TRAN TRANSACTION TRIGGER TRUNCATE TRY_CONVERT TSEQUAL UNPIVOT
+UPDATETEXT USE USER VARYING VIEW WAITFOR WHILE WITHIN WRITETEXT
+`)
@@ -0,0 +1,48 @@
+package sqb
+
+import (
+	"errors"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/internal/lexer"
+)
+
+// These errors are the causes of *stmt.BuildError which is returned by
+// Build and BuildTemplate. You can match them with errors.Is.
+var (
+	// ErrMissingExpr represents the expression for the bindVar is not bound.
+	ErrMissingExpr = errors.New("number of bindVars exceeds replaceable statements")
+	// ErrUnusedExpr represents the bound expression is not used in the strict mode.
+	ErrUnusedExpr = errors.New("unused expression")
+	// ErrMissingArg represents the arg for the placeholder "??" is not bound.
+	ErrMissingArg = errors.New("number of placeholders exceeds bound args")
+	// ErrUnusedArg represents the bound arg is not used.
+	ErrUnusedArg = errors.New("number of bound args exceeds placeholders")
+	// ErrDuplicateName represents the same name is bound more than once.
+	ErrDuplicateName = errors.New("duplicate named bindVar")
+	// ErrMissingName represents the expression for the named bindVar "{{name}}"
+	// in the base query is not bound.
+	ErrMissingName = errors.New("named bindVar is not bound")
+	// ErrUnknownName represents the bound name is not used in the base query.
+	ErrUnknownName = errors.New("unknown named bindVar in the base query")
+	// ErrTooManyParams represents the number of parameters exceeds the maximum
+	// number of the dialect. See also dialect.Dialect.MaxParams.
+	ErrTooManyParams = errors.New("too many parameters")
+	// ErrNamedParams represents the dialect does not use named parameters.
+	ErrNamedParams = errors.New("dialect does not use named parameters")
+	// ErrUnsafeSplit represents the IN cannot be split by BuildSplit because
+	// it is placed under Or or Not, or it is NOT IN.
+	ErrUnsafeSplit = errors.New("splitting IN changes the meaning of the query")
+	// ErrSplitSize represents the split size of BuildSplit is not positive.
+	ErrSplitSize = errors.New("split size should be positive")
+	// ErrMultipleSplit represents more than one IN have to be split by BuildSplit.
+	ErrMultipleSplit = errors.New("more than one IN exceed the split size")
+	// ErrUnsupportedLiteral represents the arg cannot be written as a literal
+	// by Interpolate.
+	ErrUnsupportedLiteral = dialect.ErrUnsupportedLiteral
+	// ErrUnterminated represents the string literal, the quoted identifier,
+	// the comment or the named bindVar is not terminated in the base query.
+	ErrUnterminated = lexer.ErrUnterminated
+	// ErrInvalidName represents the name of the named bindVar is invalid.
+	ErrInvalidName = lexer.ErrInvalidName
+)
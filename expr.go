@@ -0,0 +1,58 @@
+package sqb
+
+import (
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+// Func creates function call `name(args...)`.
+// Each of args is written as the expression if it implements stmt.Expr
+// such as Column, otherwise it is bound as an argument.
+func Func(name string, args ...interface{}) *stmt.Func {
+	return &stmt.Func{
+		Name: name,
+		Args: args,
+	}
+}
+
+// Cast creates type conversion `CAST(value AS typ)`.
+// It is written as `(value)::typ` on PostgreSQL.
+func Cast(value interface{}, typ string) *stmt.Cast {
+	return &stmt.Cast{
+		Value: value,
+		Type:  typ,
+	}
+}
+
+// Arith creates arithmetic expression `(left op right)`.
+func Arith(op string, left, right interface{}) *stmt.Arith {
+	return &stmt.Arith{
+		Op:    op,
+		Left:  left,
+		Right: right,
+	}
+}
+
+// WithLeft returns a copy of the condition which is written with left
+// instead of the column.
+//
+// e.g. WithLeft(Func("LOWER", Column("email")), Eq("", v)) creates
+// `LOWER(email) = ?`.
+func WithLeft(left stmt.Expr, c *stmt.Condition) *stmt.Condition {
+	cp := *c
+	cp.Left = left
+	return &cp
+}
+
+// Raw creates hand-written SQL fragment which has its own arguments.
+// Each '?' in sql is written as the placeholder of the dialect with args
+// in order. If args is a single map[string]interface{} or consists of
+// sql.NamedArg, the named markers such as ":name" are used instead.
+// See also stmt.Raw.
+//
+// e.g. Raw("ST_DWithin(geom, ?, ?)", point, 100)
+func Raw(sql string, args ...interface{}) *stmt.Raw {
+	return &stmt.Raw{
+		SQL:  sql,
+		Args: args,
+	}
+}
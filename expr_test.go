@@ -0,0 +1,56 @@
+package sqb_test
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRaw(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "mysql",
+			dialect:  dialect.MySQL{},
+			want:     "SELECT * FROM shops WHERE category = ? AND ST_DWithin(geom, ?, ?) AND attrs @> ?::jsonb",
+			wantArgs: []interface{}{"cafe", "POINT(0 0)", 100, `{"wifi":true}`},
+		},
+		{
+			name:     "postgres",
+			dialect:  dialect.PostgreSQL{},
+			want:     "SELECT * FROM shops WHERE category = $1 AND ST_DWithin(geom, $2, $3) AND attrs @> $4::jsonb",
+			wantArgs: []interface{}{"cafe", "POINT(0 0)", 100, `{"wifi":true}`},
+		},
+		{
+			name:     "sqlserver",
+			dialect:  dialect.SQLServer{},
+			want:     "SELECT * FROM shops WHERE category = @p1 AND ST_DWithin(geom, @p2, @p3) AND attrs @> @p4::jsonb",
+			wantArgs: []interface{}{"cafe", "POINT(0 0)", 100, `{"wifi":true}`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetDialect(tt.dialect)).
+				Bind(sqb.Eq("category", "cafe")).
+				Bind(sqb.Raw("ST_DWithin(geom, ?, ?)", "POINT(0 0)", 100)).
+				Bind(sqb.Raw("attrs @> :attrs::jsonb", sql.Named("attrs", `{"wifi":true}`)))
+			got, args, err := b.Build("SELECT * FROM shops WHERE ? AND ? AND ?")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
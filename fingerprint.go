@@ -0,0 +1,60 @@
+package sqb
+
+import (
+	"fmt"
+	"hash/fnv"
+
+	"github.com/Code-Hex/sqb/internal/lexer"
+	"github.com/Code-Hex/sqb/internal/pool"
+)
+
+// Fingerprint represents the shape of the query. The queries which differ
+// only in the values of args have the same Fingerprint.
+type Fingerprint struct {
+	// Text is the normalized query. Every placeholder is written as '?'
+	// and the list of IN is collapsed to "IN (?...)".
+	Text string
+	// Hash is the 64-bit FNV-1a hash of Text.
+	Hash uint64
+}
+
+// String returns Hash as 16 hexadecimal digits.
+func (f Fingerprint) String() string {
+	return fmt.Sprintf("%016x", f.Hash)
+}
+
+// Fingerprint returns the shape of the query which is built by Build.
+// It is useful to group metrics or cache entries by the query.
+//
+// The values of args are ignored, so the queries which differ only in
+// the args, including the number of values of IN, have the same Fingerprint.
+// The bound expressions are validated in the same way as Build.
+func (b *Builder) Fingerprint(baseQuery string) (Fingerprint, error) {
+	return b.fingerprint(lexer.New(baseQuery, b.marker, b.lexerRules()))
+}
+
+// FingerprintTemplate returns the shape of the query which is built
+// by BuildTemplate. See also Fingerprint.
+func (b *Builder) FingerprintTemplate(t *Template) (Fingerprint, error) {
+	if err := b.checkTemplate(t); err != nil {
+		return Fingerprint{}, err
+	}
+	return b.fingerprint(&templateScanner{tokens: t.tokens, i: -1})
+}
+
+func (b *Builder) fingerprint(s tokenScanner) (Fingerprint, error) {
+	buf := pool.Get()
+	defer pool.Put(buf)
+
+	buf.SetShape(true)
+	if err := b.write(buf, s); err != nil {
+		return Fingerprint{}, err
+	}
+	text := buf.String()
+	h := fnv.New64a()
+	h.Write([]byte(text))
+	return Fingerprint{
+		Text: text,
+		Hash: h.Sum64(),
+	}, nil
+}
@@ -0,0 +1,126 @@
+package sqb_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+func TestBuilder_Fingerprint(t *testing.T) {
+	const sql = "SELECT * FROM t WHERE ? AND ? AND created = ??"
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		a, b     []stmt.Expr
+		argsA    []interface{}
+		argsB    []interface{}
+		want     string
+		wantSame bool
+	}{
+		{
+			name:     "different values",
+			a:        []stmt.Expr{sqb.Eq("a", 1), sqb.In("b", 1, 2, 3)},
+			b:        []stmt.Expr{sqb.Eq("a", 2), sqb.In("b", 4, 5, 6, 7)},
+			argsA:    []interface{}{"x"},
+			argsB:    []interface{}{"y"},
+			want:     "SELECT * FROM t WHERE a = ? AND b IN (?...) AND created = ?",
+			wantSame: true,
+		},
+		{
+			name:     "numbered placeholders",
+			dialect:  dialect.PostgreSQL{},
+			a:        []stmt.Expr{sqb.In("b", 1), sqb.Eq("a", 1)},
+			b:        []stmt.Expr{sqb.In("b", 1, 2), sqb.Eq("a", 1)},
+			argsA:    []interface{}{"x"},
+			argsB:    []interface{}{"x"},
+			want:     "SELECT * FROM t WHERE b IN (?...) AND a = ? AND created = ?",
+			wantSame: true,
+		},
+		{
+			name: "map order",
+			a: []stmt.Expr{
+				sqb.AndFromMap(sqb.Eq, map[string]interface{}{"a": 1, "b": 2, "c": 3}),
+				sqb.Eq("d", 1),
+			},
+			b: []stmt.Expr{
+				sqb.AndFromMap(sqb.Eq, map[string]interface{}{"c": 1, "b": 2, "a": 3}),
+				sqb.Eq("d", 1),
+			},
+			argsA:    []interface{}{1},
+			argsB:    []interface{}{2},
+			want:     "SELECT * FROM t WHERE a = ? AND b = ? AND c = ? AND d = ? AND created = ?",
+			wantSame: true,
+		},
+		{
+			name:     "different columns",
+			a:        []stmt.Expr{sqb.Eq("a", 1), sqb.In("b", 1)},
+			b:        []stmt.Expr{sqb.Eq("a", 1), sqb.In("c", 1)},
+			argsA:    []interface{}{1},
+			argsB:    []interface{}{1},
+			want:     "SELECT * FROM t WHERE a = ? AND b IN (?...) AND created = ?",
+			wantSame: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fingerprint := func(exprs []stmt.Expr, args []interface{}) sqb.Fingerprint {
+				b := sqb.New(sqb.SetDialect(tt.dialect))
+				for _, expr := range exprs {
+					b = b.Bind(expr)
+				}
+				f, err := b.BindArgs(args...).Fingerprint(sql)
+				if err != nil {
+					t.Fatalf("Builder.Fingerprint() error = %v", err)
+				}
+				return f
+			}
+			a, b := fingerprint(tt.a, tt.argsA), fingerprint(tt.b, tt.argsB)
+			if a.Text != tt.want {
+				t.Errorf("Text\ngot = %q\nwant %q", a.Text, tt.want)
+			}
+			if same := a == b; same != tt.wantSame {
+				t.Errorf("a = %v (%q), b = %v (%q), want same %v", a, a.Text, b, b.Text, tt.wantSame)
+			}
+			if len(a.String()) != 16 {
+				t.Errorf("String() = %q, want 16 digits", a.String())
+			}
+		})
+	}
+}
+
+func TestBuilder_Fingerprint_Stable(t *testing.T) {
+	f, err := sqb.New().Bind(sqb.Eq("a", 1)).Fingerprint("SELECT * FROM t WHERE ?")
+	if err != nil {
+		t.Fatalf("Builder.Fingerprint() error = %v", err)
+	}
+	// FNV-1a 64-bit hash of "SELECT * FROM t WHERE a = ?"
+	want := sqb.Fingerprint{
+		Text: "SELECT * FROM t WHERE a = ?",
+		Hash: 0x43a1c1e4d003bdcf,
+	}
+	if f != want {
+		t.Errorf("Builder.Fingerprint() = %#v, want %#v", f, want)
+	}
+}
+
+func TestBuilder_FingerprintTemplate(t *testing.T) {
+	tmpl := sqb.MustCompile("SELECT * FROM t WHERE ? AND {{where}}")
+	b := sqb.New().Bind(sqb.In("a", 1, 2)).BindNamed("where", sqb.Eq("b", 1))
+	f, err := b.FingerprintTemplate(tmpl)
+	if err != nil {
+		t.Fatalf("Builder.FingerprintTemplate() error = %v", err)
+	}
+	if want := "SELECT * FROM t WHERE a IN (?...) AND b = ?"; f.Text != want {
+		t.Errorf("Text\ngot = %q\nwant %q", f.Text, want)
+	}
+
+	if _, err := sqb.New().FingerprintTemplate(tmpl); !errors.Is(err, sqb.ErrMissingExpr) {
+		t.Errorf("Builder.FingerprintTemplate() error = %v, want %v", err, sqb.ErrMissingExpr)
+	}
+	if _, err := sqb.New().Bind(sqb.In("a")).Fingerprint("SELECT * FROM t WHERE ?"); !errors.Is(err, stmt.ErrEmptyIn) {
+		t.Errorf("Builder.Fingerprint() error = %v, want %v", err, stmt.ErrEmptyIn)
+	}
+}
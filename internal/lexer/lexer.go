@@ -0,0 +1,506 @@
+package lexer
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// Rules represents quoting rules of SQL dialect.
+type Rules struct {
+	// BackslashEscapes indicates backslash escapes the next character in
+	// the string literals.
+	BackslashEscapes bool
+	// EscapeStrings indicates E'...' string literals are able to use
+	// backslash escapes even if BackslashEscapes is disabled.
+	EscapeStrings bool
+	// TripleQuotes indicates '''...''' and """...""" string literals.
+	TripleQuotes bool
+	// DollarQuotes indicates $$...$$ and $tag$...$tag$ string literals.
+	DollarQuotes bool
+	// HashComments indicates '#' starts a comment to the end of line.
+	HashComments bool
+	// DashCommentSpace indicates "--" is a comment only when it is followed
+	// by a whitespace or a control character.
+	DashCommentSpace bool
+	// NestedComments indicates the block comments can be nested.
+	NestedComments bool
+	// BracketQuotes indicates [...] quoted identifiers.
+	BracketQuotes bool
+	// BracketEscapes indicates "]]" escapes ']' in the [...] quoted
+	// identifiers.
+	BracketEscapes bool
+	// QQuotes indicates q'[...]' string literals which use
+	// alternative quoting mechanism.
+	QQuotes bool
+}
+
+var (
+	// Standard represents quoting rules of standard SQL.
+	Standard = Rules{}
+	// MySQL represents quoting rules of MySQL.
+	MySQL = Rules{
+		BackslashEscapes: true,
+		HashComments:     true,
+		DashCommentSpace: true,
+	}
+	// PostgreSQL represents quoting rules of PostgreSQL.
+	PostgreSQL = Rules{
+		EscapeStrings:  true,
+		DollarQuotes:   true,
+		NestedComments: true,
+	}
+	// Spanner represents quoting rules of Cloud Spanner.
+	Spanner = Rules{
+		BackslashEscapes: true,
+		TripleQuotes:     true,
+		HashComments:     true,
+	}
+	// SQLite represents quoting rules of SQLite.
+	SQLite = Rules{
+		BracketQuotes: true,
+	}
+	// Oracle represents quoting rules of Oracle Database.
+	Oracle = Rules{
+		QQuotes: true,
+	}
+	// SQLServer represents quoting rules of SQL Server.
+	SQLServer = Rules{
+		BracketQuotes:  true,
+		BracketEscapes: true,
+		NestedComments: true,
+	}
+)
+
+// RulesOf returns quoting rules of the dialect which is named name such
+// as "mysql". It returns Standard if the dialect is unknown.
+func RulesOf(name string) Rules {
+	switch name {
+	case "mysql":
+		return MySQL
+	case "postgres":
+		return PostgreSQL
+	case "spanner":
+		return Spanner
+	case "sqlite":
+		return SQLite
+	case "sqlserver":
+		return SQLServer
+	case "oracle":
+		return Oracle
+	default:
+		return Standard
+	}
+}
+
+// Kind represents kind of the token.
+type Kind int
+
+const (
+	// Text represents a part of the query which is written as it is.
+	// It includes string literals, quoted identifiers and comments.
+	Text Kind = iota
+	// Slot represents a slot marker which is replaced with the expression.
+	// The slot marker is '?' by default.
+	Slot
+	// NamedSlot represents a "{{name}}" slot which is replaced with the
+	// expression bound by the name.
+	NamedSlot
+	// Placeholder represents a doubled slot marker such as "??". It is
+	// replaced with a placeholder of the database driver.
+	Placeholder
+	// NamedParam represents a named parameter such as ":name". It is
+	// scanned only if the prefix is set by SetParamPrefix.
+	NamedParam
+)
+
+// Token represents a token of the base query.
+type Token struct {
+	Kind  Kind
+	Value string
+	// Name is the name of NamedSlot or NamedParam.
+	Name string
+	// Pos is the byte offset of the token in the base query.
+	Pos int
+}
+
+var (
+	// ErrUnterminated represents the literal, the comment or the named slot
+	// is not terminated.
+	ErrUnterminated = errors.New("unterminated")
+	// ErrInvalidName represents the name of the named slot is invalid.
+	ErrInvalidName = errors.New("invalid name")
+)
+
+// Error represents an error which is occurred while scanning the base query.
+type Error struct {
+	Pos int
+	Msg string
+	Err error
+}
+
+func (e *Error) Error() string {
+	return e.Msg + " at position " + strconv.Itoa(e.Pos)
+}
+
+// Unwrap unwraps the wrapped error.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Scanner splits the base query into Text, Slot, NamedSlot and
+// Placeholder tokens.
+//
+// The slot markers and "{{name}}" which are placed in string literals,
+// quoted identifiers or comments are not treated as slots.
+type Scanner struct {
+	src    string
+	marker string
+	rules  Rules
+	prefix byte
+	pos    int
+	tok    Token
+	err    error
+}
+
+// New returns a new Scanner to read from src. The marker is used as
+// the slot marker. If marker is empty, '?' is used.
+func New(src, marker string, rules Rules) *Scanner {
+	if marker == "" {
+		marker = "?"
+	}
+	return &Scanner{
+		src:    src,
+		marker: marker,
+		rules:  rules,
+	}
+}
+
+// SetParamPrefix enables to scan the named parameters which start with
+// prefix such as ":name". The prefix which is doubled such as "::" or
+// follows an identifier such as "a[1:n]" is not treated as a parameter.
+func (s *Scanner) SetParamPrefix(prefix byte) {
+	s.prefix = prefix
+}
+
+// Scan advances the Scanner to the next token, which will then be
+// available through the Token method. It returns false when the scan
+// stops, either by reaching the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	if s.err != nil || s.pos >= len(s.src) {
+		return false
+	}
+	start := s.pos
+	for s.pos < len(s.src) {
+		if strings.HasPrefix(s.src[s.pos:], s.marker) {
+			if s.pos > start {
+				break
+			}
+			s.pos += len(s.marker)
+			kind := Slot
+			if strings.HasPrefix(s.src[s.pos:], s.marker) {
+				s.pos += len(s.marker)
+				kind = Placeholder
+			}
+			s.tok = Token{Kind: kind, Value: s.src[start:s.pos], Pos: start}
+			return true
+		}
+		if strings.HasPrefix(s.src[s.pos:], "{{") {
+			if s.pos > start {
+				break
+			}
+			return s.scanNamedSlot()
+		}
+		if s.isNamedParam(s.pos) {
+			if s.pos > start {
+				break
+			}
+			return s.scanNamedParam()
+		}
+		end, err := s.skip(s.pos)
+		if err != nil {
+			s.err = err
+			return false
+		}
+		s.pos = end
+	}
+	s.tok = Token{Kind: Text, Value: s.src[start:s.pos], Pos: start}
+	return true
+}
+
+// scanNamedSlot scans "{{name}}". The name can be surrounded by spaces.
+func (s *Scanner) scanNamedSlot() bool {
+	start := s.pos
+	end := strings.Index(s.src[start:], "}}")
+	if end == -1 {
+		s.err = &Error{Pos: start, Msg: "unterminated named slot", Err: ErrUnterminated}
+		return false
+	}
+	end += start + len("}}")
+	name := strings.TrimSpace(s.src[start+len("{{") : end-len("}}")])
+	if !isName(name) {
+		s.err = &Error{Pos: start, Msg: "invalid named slot " + strconv.Quote(s.src[start:end]), Err: ErrInvalidName}
+		return false
+	}
+	s.pos = end
+	s.tok = Token{Kind: NamedSlot, Value: s.src[start:end], Name: name, Pos: start}
+	return true
+}
+
+// scanNamedParam scans the named parameter such as ":name".
+func (s *Scanner) scanNamedParam() bool {
+	start := s.pos
+	end := start + 1
+	for end < len(s.src) && isIdentChar(s.src[end]) && s.src[end] != '$' && s.src[end] < 0x80 {
+		end++
+	}
+	s.pos = end
+	s.tok = Token{Kind: NamedParam, Value: s.src[start:end], Name: s.src[start+1 : end], Pos: start}
+	return true
+}
+
+// isNamedParam reports whether the prefix at i starts a named parameter.
+func (s *Scanner) isNamedParam(i int) bool {
+	if s.prefix == 0 || s.src[i] != s.prefix || i+1 >= len(s.src) {
+		return false
+	}
+	if c := s.src[i+1]; isDigit(c) || !isName(string(c)) {
+		return false
+	}
+	return i == 0 || s.src[i-1] != s.prefix && !isIdentChar(s.src[i-1])
+}
+
+// Token returns the most recent token generated by a call to Scan.
+func (s *Scanner) Token() Token {
+	return s.tok
+}
+
+// Err returns the error that was encountered by the Scanner.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// skip returns the position next to the literal, the identifier or the comment
+// which is started from i. If there is no such thing, it returns i+1.
+func (s *Scanner) skip(i int) (int, error) {
+	switch c := s.src[i]; c {
+	case '\'':
+		backslash := s.rules.BackslashEscapes || s.rules.EscapeStrings && s.isEscapeString(i)
+		return s.skipQuoted(i, backslash)
+	case '"':
+		return s.skipQuoted(i, s.rules.BackslashEscapes)
+	case '`':
+		return s.skipQuoted(i, false)
+	case '[':
+		if s.rules.BracketQuotes {
+			return s.skipBracketQuoted(i)
+		}
+	case 'q', 'Q':
+		if s.rules.QQuotes && s.isQQuote(i) {
+			return s.skipQQuoted(i)
+		}
+	case '$':
+		if s.rules.DollarQuotes {
+			return s.skipDollarQuoted(i)
+		}
+	case '#':
+		if s.rules.HashComments {
+			return s.skipLineComment(i), nil
+		}
+	case '-':
+		if s.isDashComment(i) {
+			return s.skipLineComment(i), nil
+		}
+	case '/':
+		if strings.HasPrefix(s.src[i:], "/*") {
+			return s.skipBlockComment(i)
+		}
+	}
+	return i + 1, nil
+}
+
+func (s *Scanner) skipQuoted(i int, backslash bool) (int, error) {
+	q := s.src[i]
+	if s.rules.TripleQuotes && q != '`' {
+		triple := s.src[i : i+1]
+		triple += triple + triple
+		if strings.HasPrefix(s.src[i:], triple) {
+			return s.skipTripleQuoted(i, triple)
+		}
+	}
+	for j := i + 1; j < len(s.src); j++ {
+		switch s.src[j] {
+		case '\\':
+			if backslash {
+				j++
+			}
+		case q:
+			// doubled quote is an escaped quote.
+			if j+1 < len(s.src) && s.src[j+1] == q {
+				j++
+				continue
+			}
+			return j + 1, nil
+		}
+	}
+	return 0, &Error{Pos: i, Msg: "unterminated quoted string", Err: ErrUnterminated}
+}
+
+func (s *Scanner) skipBracketQuoted(i int) (int, error) {
+	for j := i + 1; j < len(s.src); j++ {
+		if s.src[j] != ']' {
+			continue
+		}
+		// doubled bracket is an escaped bracket.
+		if s.rules.BracketEscapes && j+1 < len(s.src) && s.src[j+1] == ']' {
+			j++
+			continue
+		}
+		return j + 1, nil
+	}
+	return 0, &Error{Pos: i, Msg: "unterminated quoted identifier", Err: ErrUnterminated}
+}
+
+// skipQQuoted skips q'<delimiter>...<delimiter>' string literal.
+func (s *Scanner) skipQQuoted(i int) (int, error) {
+	delim := s.src[i+2]
+	switch delim {
+	case '[':
+		delim = ']'
+	case '{':
+		delim = '}'
+	case '<':
+		delim = '>'
+	case '(':
+		delim = ')'
+	}
+	for j := i + 3; j+1 < len(s.src); j++ {
+		if s.src[j] == delim && s.src[j+1] == '\'' {
+			return j + 2, nil
+		}
+	}
+	return 0, &Error{Pos: i, Msg: "unterminated quoted string", Err: ErrUnterminated}
+}
+
+func (s *Scanner) skipTripleQuoted(i int, triple string) (int, error) {
+	for j := i + len(triple); j < len(s.src); j++ {
+		if s.src[j] == '\\' {
+			j++
+			continue
+		}
+		if strings.HasPrefix(s.src[j:], triple) {
+			return j + len(triple), nil
+		}
+	}
+	return 0, &Error{Pos: i, Msg: "unterminated triple-quoted string", Err: ErrUnterminated}
+}
+
+func (s *Scanner) skipDollarQuoted(i int) (int, error) {
+	if i > 0 && isIdentChar(s.src[i-1]) {
+		return i + 1, nil
+	}
+	// find "$tag$"
+	j := i + 1
+	for ; j < len(s.src) && s.src[j] != '$'; j++ {
+		if !isIdentChar(s.src[j]) {
+			return i + 1, nil
+		}
+	}
+	if j >= len(s.src) {
+		return i + 1, nil
+	}
+	tag := s.src[i : j+1]
+	// "$1" is a positional parameter of PostgreSQL.
+	if len(tag) > 2 && isDigit(tag[1]) {
+		return i + 1, nil
+	}
+	end := strings.Index(s.src[j+1:], tag)
+	if end == -1 {
+		return 0, &Error{Pos: i, Msg: "unterminated dollar-quoted string", Err: ErrUnterminated}
+	}
+	return j + 1 + end + len(tag), nil
+}
+
+func (s *Scanner) skipLineComment(i int) int {
+	end := strings.IndexByte(s.src[i:], '\n')
+	if end == -1 {
+		return len(s.src)
+	}
+	return i + end + 1
+}
+
+func (s *Scanner) skipBlockComment(i int) (int, error) {
+	depth := 0
+	for j := i; j+1 < len(s.src); j++ {
+		switch s.src[j : j+2] {
+		case "/*":
+			if depth == 0 || s.rules.NestedComments {
+				depth++
+			}
+			j++
+		case "*/":
+			depth--
+			j++
+			if depth == 0 {
+				return j + 1, nil
+			}
+		}
+	}
+	return 0, &Error{Pos: i, Msg: "unterminated block comment", Err: ErrUnterminated}
+}
+
+func (s *Scanner) isDashComment(i int) bool {
+	if !strings.HasPrefix(s.src[i:], "--") {
+		return false
+	}
+	if !s.rules.DashCommentSpace {
+		return true
+	}
+	// MySQL requires the second dash to be followed by at least
+	// one whitespace or control character.
+	return i+2 >= len(s.src) || s.src[i+2] <= ' '
+}
+
+// isQQuote reports whether the q at i starts q'...' or nq'...' string.
+func (s *Scanner) isQQuote(i int) bool {
+	if i+2 >= len(s.src) || s.src[i+1] != '\'' {
+		return false
+	}
+	if i > 0 && (s.src[i-1] == 'n' || s.src[i-1] == 'N') {
+		i--
+	}
+	return i == 0 || !isIdentChar(s.src[i-1])
+}
+
+// isEscapeString reports whether the quote at i starts E'...' string.
+func (s *Scanner) isEscapeString(i int) bool {
+	if i == 0 || s.src[i-1] != 'E' && s.src[i-1] != 'e' {
+		return false
+	}
+	return i == 1 || !isIdentChar(s.src[i-2])
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+// isName reports whether s is usable as the name of NamedSlot.
+func isName(s string) bool {
+	if s == "" || isDigit(s[0]) {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '$' || c >= 0x80 || !isIdentChar(c) {
+			return false
+		}
+	}
+	return true
+}
+
+func isIdentChar(c byte) bool {
+	return 'a' <= c && c <= 'z' ||
+		'A' <= c && c <= 'Z' ||
+		isDigit(c) ||
+		c == '_' || c == '$' || c >= 0x80
+}
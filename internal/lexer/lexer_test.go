@@ -0,0 +1,373 @@
+package lexer
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestScanner(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		marker  string
+		rules   Rules
+		want    []Token
+		wantErr bool
+	}{
+		{
+			name:  "empty",
+			src:   "",
+			rules: MySQL,
+			want:  nil,
+		},
+		{
+			name:  "slots",
+			src:   "SELECT * FROM ? WHERE ?",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT * FROM ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 14},
+				{Kind: Text, Value: " WHERE ", Pos: 15},
+				{Kind: Slot, Value: "?", Pos: 22},
+			},
+		},
+		{
+			name:  "string literals",
+			src:   `SELECT 'what?', "how?", 'it''s?' WHERE ?`,
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: `SELECT 'what?', "how?", 'it''s?' WHERE `, Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 39},
+			},
+		},
+		{
+			name:  "backslash escapes on mysql",
+			src:   `SELECT 'it\'s?' WHERE ?`,
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: `SELECT 'it\'s?' WHERE `, Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 22},
+			},
+		},
+		{
+			name:  "backslash is not escape on postgresql",
+			src:   `SELECT 'C:\' WHERE ?`,
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: `SELECT 'C:\' WHERE `, Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 19},
+			},
+		},
+		{
+			name:  "escape string on postgresql",
+			src:   `SELECT E'it\'s?' WHERE ?`,
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: `SELECT E'it\'s?' WHERE `, Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 23},
+			},
+		},
+		{
+			name:  "quoted identifier",
+			src:   "SELECT `col?` FROM t WHERE ?",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT `col?` FROM t WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 27},
+			},
+		},
+		{
+			name:  "comments",
+			src:   "SELECT 1 -- why?\n# why?\n/* why? */ WHERE ?",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT 1 -- why?\n# why?\n/* why? */ WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 41},
+			},
+		},
+		{
+			name:  "double dash without space on mysql",
+			src:   "SELECT 1--?",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT 1--", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 10},
+			},
+		},
+		{
+			name:  "hash is operator on postgresql",
+			src:   "SELECT 1 # ?",
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT 1 # ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 11},
+			},
+		},
+		{
+			name:  "nested comments on postgresql",
+			src:   "/* a /* b? */ c? */ ?",
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "/* a /* b? */ c? */ ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 20},
+			},
+		},
+		{
+			name:  "dollar quotes on postgresql",
+			src:   "SELECT $$what?$$, $fn$it's?$fn$, $1 WHERE ?",
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT $$what?$$, $fn$it's?$fn$, $1 WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 42},
+			},
+		},
+		{
+			name:  "triple quotes on spanner",
+			src:   `SELECT '''it's?''' WHERE ?`,
+			rules: Spanner,
+			want: []Token{
+				{Kind: Text, Value: `SELECT '''it's?''' WHERE `, Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 25},
+			},
+		},
+		{
+			name:  "bracket quotes on sqlite",
+			src:   "SELECT [what?] FROM t WHERE ?",
+			rules: SQLite,
+			want: []Token{
+				{Kind: Text, Value: "SELECT [what?] FROM t WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 28},
+			},
+		},
+		{
+			name:  "bracket quotes on sqlserver",
+			src:   "SELECT [what?]]] FROM t /* a /* b? */ c? */ WHERE ?",
+			rules: SQLServer,
+			want: []Token{
+				{Kind: Text, Value: "SELECT [what?]]] FROM t /* a /* b? */ c? */ WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 50},
+			},
+		},
+		{
+			name:  "escaped bracket on sqlserver",
+			src:   "SELECT [x]]?] FROM t WHERE ?",
+			rules: SQLServer,
+			want: []Token{
+				{Kind: Text, Value: "SELECT [x]]?] FROM t WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 27},
+			},
+		},
+		{
+			name:  "doubled bracket on sqlite",
+			src:   "SELECT [x]]?] FROM t",
+			rules: SQLite,
+			want: []Token{
+				{Kind: Text, Value: "SELECT [x]]", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 11},
+				{Kind: Text, Value: "] FROM t", Pos: 12},
+			},
+		},
+		{
+			name:    "unterminated escaped bracket on sqlserver",
+			src:     "SELECT [x]]? FROM t",
+			rules:   SQLServer,
+			wantErr: true,
+		},
+		{
+			name:  "alternative quotes on oracle",
+			src:   "SELECT q'[it's?]', Nq'{what?}', q FROM t WHERE ?",
+			rules: Oracle,
+			want: []Token{
+				{Kind: Text, Value: "SELECT q'[it's?]', Nq'{what?}', q FROM t WHERE ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 47},
+			},
+		},
+		{
+			name:  "brackets on postgresql",
+			src:   "SELECT arr[?] FROM t",
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT arr[", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 11},
+				{Kind: Text, Value: "] FROM t", Pos: 12},
+			},
+		},
+		{
+			name:  "named slots",
+			src:   "SELECT * FROM t WHERE {{where}} ORDER BY {{ order }}",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT * FROM t WHERE ", Pos: 0},
+				{Kind: NamedSlot, Value: "{{where}}", Name: "where", Pos: 22},
+				{Kind: Text, Value: " ORDER BY ", Pos: 31},
+				{Kind: NamedSlot, Value: "{{ order }}", Name: "order", Pos: 41},
+			},
+		},
+		{
+			name:  "named slot in string literal",
+			src:   "SELECT '{{where}}' FROM t WHERE {{where}}",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT '{{where}}' FROM t WHERE ", Pos: 0},
+				{Kind: NamedSlot, Value: "{{where}}", Name: "where", Pos: 32},
+			},
+		},
+		{
+			name:    "unterminated named slot",
+			src:     "SELECT * FROM t WHERE {{where",
+			rules:   MySQL,
+			wantErr: true,
+		},
+		{
+			name:    "invalid named slot",
+			src:     "SELECT * FROM t WHERE {{wh ere}}",
+			rules:   MySQL,
+			wantErr: true,
+		},
+		{
+			name:  "doubled slot marker",
+			src:   "SELECT * FROM t WHERE id = ?? AND ?",
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT * FROM t WHERE id = ", Pos: 0},
+				{Kind: Placeholder, Value: "??", Pos: 27},
+				{Kind: Text, Value: " AND ", Pos: 29},
+				{Kind: Slot, Value: "?", Pos: 34},
+			},
+		},
+		{
+			name:   "custom slot marker",
+			src:    "SELECT * FROM t WHERE data ?| array['a'] AND %s AND id = %s%s",
+			marker: "%s",
+			rules:  PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT * FROM t WHERE data ?| array['a'] AND ", Pos: 0},
+				{Kind: Slot, Value: "%s", Pos: 45},
+				{Kind: Text, Value: " AND id = ", Pos: 47},
+				{Kind: Placeholder, Value: "%s%s", Pos: 57},
+			},
+		},
+		{
+			name:   "custom slot marker in string literal",
+			src:    "SELECT '%s' WHERE %s",
+			marker: "%s",
+			rules:  MySQL,
+			want: []Token{
+				{Kind: Text, Value: "SELECT '%s' WHERE ", Pos: 0},
+				{Kind: Slot, Value: "%s", Pos: 18},
+			},
+		},
+		{
+			name:    "unterminated string",
+			src:     "SELECT 'what? WHERE ?",
+			rules:   MySQL,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated block comment",
+			src:     "SELECT 1 /* what? WHERE ?",
+			rules:   MySQL,
+			wantErr: true,
+		},
+		{
+			name:    "unterminated dollar quotes",
+			src:     "SELECT $tag$what? WHERE ?",
+			rules:   PostgreSQL,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Token
+			s := New(tt.src, tt.marker, tt.rules)
+			for s.Scan() {
+				got = append(got, s.Token())
+			}
+			if err := s.Err(); (err != nil) != tt.wantErr {
+				t.Fatalf("Scanner.Err() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("tokens (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestScanner_NamedParam(t *testing.T) {
+	tests := []struct {
+		name  string
+		src   string
+		rules Rules
+		want  []Token
+	}{
+		{
+			name:  "params",
+			src:   "a = :a AND b = :b_2",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "a = ", Pos: 0},
+				{Kind: NamedParam, Value: ":a", Name: "a", Pos: 4},
+				{Kind: Text, Value: " AND b = ", Pos: 6},
+				{Kind: NamedParam, Value: ":b_2", Name: "b_2", Pos: 15},
+			},
+		},
+		{
+			name:  "cast and slice",
+			src:   "x::jsonb @> :v AND arr[1:n] = ':s'",
+			rules: PostgreSQL,
+			want: []Token{
+				{Kind: Text, Value: "x::jsonb @> ", Pos: 0},
+				{Kind: NamedParam, Value: ":v", Name: "v", Pos: 12},
+				{Kind: Text, Value: " AND arr[1:n] = ':s'", Pos: 14},
+			},
+		},
+		{
+			name:  "not a name",
+			src:   "a = :1 OR b = : ",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "a = :1 OR b = : ", Pos: 0},
+			},
+		},
+		{
+			name:  "with slot",
+			src:   "a = ? AND b = :b",
+			rules: MySQL,
+			want: []Token{
+				{Kind: Text, Value: "a = ", Pos: 0},
+				{Kind: Slot, Value: "?", Pos: 4},
+				{Kind: Text, Value: " AND b = ", Pos: 5},
+				{Kind: NamedParam, Value: ":b", Name: "b", Pos: 14},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []Token
+			s := New(tt.src, "", tt.rules)
+			s.SetParamPrefix(':')
+			for s.Scan() {
+				got = append(got, s.Token())
+			}
+			if err := s.Err(); err != nil {
+				t.Fatalf("Scanner.Err() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("tokens (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRulesOf(t *testing.T) {
+	if diff := cmp.Diff(PostgreSQL, RulesOf("postgres")); diff != "" {
+		t.Errorf("RulesOf(postgres) (-want, +got)\n%s", diff)
+	}
+	if diff := cmp.Diff(Standard, RulesOf("unknown")); diff != "" {
+		t.Errorf("RulesOf(unknown) (-want, +got)\n%s", diff)
+	}
+}
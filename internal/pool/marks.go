@@ -0,0 +1,104 @@
+package pool
+
+import (
+	"io"
+	"reflect"
+	"strings"
+)
+
+// appendArg appends arg and returns the number of the placeholder which
+// references arg. If SetDedupArgs is enabled, the identical arg is not
+// appended again.
+func (b *Builder) appendArg(arg interface{}) int {
+	hashable := b.dedup && isHashable(arg)
+	if hashable {
+		if n, ok := b.seen[arg]; ok {
+			return n
+		}
+	}
+	b.args = append(b.args, arg)
+	n := len(b.args)
+	if hashable {
+		if b.seen == nil {
+			b.seen = make(map[interface{}]int)
+		}
+		b.seen[arg] = n
+	}
+	return n
+}
+
+// bind numbers the oldest placeholder which is not numbered yet.
+// If there is no such placeholder, n is queued for the next placeholder
+// because stmt.Expr may append the arg before writing the placeholder.
+func (b *Builder) bind(n int) {
+	if b.pending < len(b.marks) {
+		b.marks[b.pending].n = n
+		b.pending++
+		return
+	}
+	b.unbound = append(b.unbound, n)
+}
+
+// addMark adds the placeholder at the current position. It is numbered by
+// the oldest arg which is appended before any placeholders reference it.
+func (b *Builder) addMark() {
+	m := mark{pos: b.size}
+	if len(b.unbound) > 0 {
+		m.n = b.unbound[0]
+		b.unbound = b.unbound[1:]
+		b.pending++
+	}
+	b.marks = append(b.marks, m)
+}
+
+// writeMarks returns the query which the placeholders are written into
+// by write. The placeholders which are not bound to any args are numbered
+// after the appended args.
+func (b *Builder) writeMarks(write func(w io.StringWriter, n int) error) (string, error) {
+	query := b.buf.String()
+	next := len(b.args)
+
+	var sb strings.Builder
+	sb.Grow(len(query) + len(b.marks)*4)
+	last := 0
+	for _, m := range b.marks {
+		sb.WriteString(query[last:m.pos])
+		n := m.n
+		if n == 0 {
+			next++
+			n = next
+		}
+		if err := write(&sb, n); err != nil {
+			return "", err
+		}
+		last = m.pos
+	}
+	sb.WriteString(query[last:])
+	return sb.String(), nil
+}
+
+// isHashable reports whether v can be used as a key of map safely.
+// The value which contains interfaces is not hashable because the dynamic
+// value might not be comparable.
+func isHashable(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	return hashableType(reflect.TypeOf(v))
+}
+
+func hashableType(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Map, reflect.Func, reflect.Interface:
+		return false
+	case reflect.Array:
+		return hashableType(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if !hashableType(t.Field(i).Type) {
+				return false
+			}
+		}
+	}
+	return true
+}
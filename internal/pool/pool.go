@@ -1,48 +1,169 @@
 package pool
 
-import "strconv"
-
-// These variables are the same as defined variables at sqb.go.
-const (
-	// Question represents a '?' placeholder parameter.
-	Question = iota
-	// Dollar represents a '$1', '$2'... placeholder parameters.
-	Dollar
-	// AtMark represents a '@1', '@2'... placeholder parameters.
-	AtMark
+import (
+	"io"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+var (
+	_ stmt.DialectBuilder = (*Builder)(nil)
+	_ stmt.ShapeBuilder   = (*Builder)(nil)
+	_ stmt.InBuilder      = (*Builder)(nil)
+	_ stmt.NullBuilder    = (*Builder)(nil)
+	_ stmt.PagingBuilder  = (*Builder)(nil)
 )
 
 // Builder is the interface that wraps the basic
 // Reset, Cap and WriteString method.
 type Builder struct {
-	Placeholder int
-
+	dialect dialect.Dialect
 	buf     Buffer
 	args    []interface{}
 	counter int
+	shape   bool
+	in      stmt.InOptions
+	nilNull bool
+	paging  stmt.Paging
+
+	// The fields below are used to write placeholders after the args are
+	// appended. See SetDedupArgs and SetInterpolate.
+	dedup       bool
+	interpolate bool
+	size        int
+	marks       []mark
+	pending     int
+	unbound     []int
+	seen        map[interface{}]int
+}
+
+// mark represents the placeholder which is written after the query is built.
+// pos is the byte offset of the query and n is the number of the placeholder.
+// n is zero until the arg is appended.
+type mark struct {
+	pos, n int
+}
+
+// SetInOptions sets the options of stmt.CompIn.
+func (b *Builder) SetInOptions(in stmt.InOptions) {
+	b.in = in
+}
+
+// InOptions returns the options of stmt.CompIn.
+// This method is implemented to satisfy stmt.InBuilder.
+func (b *Builder) InOptions() stmt.InOptions {
+	return b.in
+}
+
+// SetNilAsNull sets whether nil values are compared as NULL.
+func (b *Builder) SetNilAsNull(nilAsNull bool) {
+	b.nilNull = nilAsNull
+}
+
+// NilAsNull reports whether nil values are compared as NULL.
+// This method is implemented to satisfy stmt.NullBuilder.
+func (b *Builder) NilAsNull() bool {
+	return b.nilNull
+}
+
+// Paging returns which of stmt.Limit and stmt.Offset have been written.
+// This method is implemented to satisfy stmt.PagingBuilder.
+func (b *Builder) Paging() stmt.Paging {
+	return b.paging
+}
+
+// SetPaging records which of stmt.Limit and stmt.Offset have been written.
+// This method is implemented to satisfy stmt.PagingBuilder.
+func (b *Builder) SetPaging(p stmt.Paging) {
+	b.paging = p
+}
+
+// SetShape sets whether the builder writes the shape of the query.
+// In the shape mode, placeholders are written as '?' and args are ignored.
+func (b *Builder) SetShape(shape bool) {
+	b.shape = shape
 }
 
-// WritePlaceholder writes placeholder.
+// Shape reports whether the builder writes the shape of the query.
+// This method is implemented to satisfy stmt.ShapeBuilder.
+func (b *Builder) Shape() bool {
+	return b.shape
+}
+
+// SetDedupArgs sets whether the identical args reuse the same placeholder.
+//
+// It should be enabled only if the dialect uses numbered placeholders.
+// When it is enabled, placeholders are numbered when the args are appended,
+// and they are written into the query by String.
+func (b *Builder) SetDedupArgs(dedup bool) {
+	b.dedup = dedup
+}
+
+// SetInterpolate sets whether placeholders are kept to be replaced with
+// the literals of the args by Interpolate.
+func (b *Builder) SetInterpolate(interpolate bool) {
+	b.interpolate = interpolate
+}
+
+// deferred reports whether placeholders are written after the args are appended.
+func (b *Builder) deferred() bool {
+	return b.dedup || b.interpolate
+}
+
+// SetDialect sets the dialect which is used to write the query.
+func (b *Builder) SetDialect(d dialect.Dialect) {
+	b.dialect = d
+}
+
+// Dialect returns the dialect which is used to write the query.
+// If the dialect is not set, it returns dialect.MySQL.
+func (b *Builder) Dialect() dialect.Dialect {
+	if b.dialect == nil {
+		return dialect.MySQL{}
+	}
+	return b.dialect
+}
+
+// WritePlaceholder writes placeholder which is decided by the dialect.
 func (b *Builder) WritePlaceholder() {
-	switch b.Placeholder {
-	case AtMark:
-		b.counter++
-		b.buf.WriteString("@")
-		b.buf.WriteString(strconv.Itoa(b.counter))
-	case Dollar:
-		b.counter++
-		b.buf.WriteString("$")
-		b.buf.WriteString(strconv.Itoa(b.counter))
-	case Question:
-		fallthrough
-	default:
-		b.buf.WriteString("?")
+	if b.shape {
+		b.WriteString("?")
+		return
 	}
+	if b.deferred() {
+		b.addMark()
+		return
+	}
+	b.counter++
+	b.Dialect().WritePlaceholder(b.buf, b.counter)
 }
 
 // String returns appended the contents.
 func (b *Builder) String() string {
-	return b.buf.String()
+	if len(b.marks) == 0 {
+		return b.buf.String()
+	}
+	d := b.Dialect()
+	s, _ := b.writeMarks(func(w io.StringWriter, n int) error {
+		d.WritePlaceholder(w, n)
+		return nil
+	})
+	return s
+}
+
+// Interpolate returns the query which the placeholders are replaced with
+// the literals. literal receives the number of the placeholder which starts
+// from 1. SetInterpolate should be enabled before writing the query.
+func (b *Builder) Interpolate(literal func(n int) (string, error)) (string, error) {
+	return b.writeMarks(func(w io.StringWriter, n int) error {
+		s, err := literal(n)
+		if err != nil {
+			return err
+		}
+		w.WriteString(s)
+		return nil
+	})
 }
 
 // Args return appended args.
@@ -60,18 +181,43 @@ func (b *Builder) Args() []interface{} {
 // bytes.Buffer
 // https://golang.org/pkg/bytes/#Buffer.WriteString
 func (b *Builder) WriteString(s string) {
+	b.size += len(s)
 	b.buf.WriteString(s)
 }
 
 // AppendArgs appends the args.
+//
+// If SetDedupArgs is enabled, the arg which is identical to the appended arg
+// is not appended again, and the placeholder reuses the number of it.
 func (b *Builder) AppendArgs(args ...interface{}) {
-	b.args = append(b.args, args...)
+	if b.shape {
+		return
+	}
+	if !b.deferred() {
+		b.args = append(b.args, args...)
+		return
+	}
+	for _, arg := range args {
+		b.bind(b.appendArg(arg))
+	}
 }
 
 // Reset resets Builder.
 func (b *Builder) Reset() {
 	b.args = []interface{}{}
 	b.counter = 0
+	b.dialect = nil
+	b.shape = false
+	b.in = stmt.InOptions{}
+	b.nilNull = false
+	b.paging = 0
+	b.dedup = false
+	b.interpolate = false
+	b.size = 0
+	b.marks = b.marks[:0]
+	b.pending = 0
+	b.unbound = b.unbound[:0]
+	b.seen = nil
 	// Proper usage of a sync.Pool requires each entry to have approximately
 	// the same memory cost. To obtain this property when the stored type
 	// contains a variably-sized buffer, we add a hard limit on the maximum buffer
@@ -0,0 +1,60 @@
+package sqb
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/internal/lexer"
+	"github.com/Code-Hex/sqb/internal/pool"
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+// DisplayOnly is the comment which is written at the head of the query
+// returned by Interpolate.
+const DisplayOnly = "/* sqb: interpolated for display only */ "
+
+// Interpolate builds sql query string like Build, but the placeholders are
+// replaced with the escaped literals of the args for the dialect.
+//
+// The returned query is intended to display such as logs and debugging,
+// so it starts with DisplayOnly comment. Do not execute it, use Build instead.
+// If the arg cannot be written as a literal safely, Interpolate returns
+// an error which wraps ErrUnsupportedLiteral. See also dialect.Literal.
+func (b *Builder) Interpolate(baseQuery string) (string, error) {
+	buf := pool.Get()
+	defer pool.Put(buf)
+
+	buf.SetInterpolate(true)
+	if err := b.write(buf, lexer.New(baseQuery, b.marker, b.lexerRules())); err != nil {
+		return "", err
+	}
+	d, args := buf.Dialect(), buf.Args()
+	query, err := buf.Interpolate(func(n int) (string, error) {
+		if n > len(args) {
+			return "", argError(n-1, nil, ErrMissingArg)
+		}
+		s, err := dialect.Literal(d, args[n-1])
+		if err != nil {
+			return "", argError(n-1, args[n-1], err)
+		}
+		return s, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return DisplayOnly + query, nil
+}
+
+// argError returns an error which is occurred at the i-th arg of the built query.
+func argError(i int, arg interface{}, err error) *stmt.BuildError {
+	e := &stmt.BuildError{
+		Op:   "args[" + strconv.Itoa(i) + "]",
+		Slot: -1,
+		Err:  err,
+	}
+	if arg != nil {
+		e.Type = fmt.Sprintf("%T", arg)
+	}
+	return e
+}
@@ -0,0 +1,104 @@
+package sqb_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+func TestBuilder_Interpolate(t *testing.T) {
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	tests := []struct {
+		name  string
+		opts  []sqb.Option
+		stmts []stmt.Expr
+		args  []interface{}
+		want  string
+	}{
+		{
+			name: "mysql",
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "it's"),
+				sqb.In("id", 1, 2),
+			},
+			args: []interface{}{ts},
+			want: sqb.DisplayOnly + "SELECT * FROM t WHERE name = 'it\\'s' AND id IN (1, 2) AND created = '2020-01-02 03:04:05'",
+		},
+		{
+			name: "postgresql",
+			opts: []sqb.Option{sqb.SetDialect(dialect.PostgreSQL{})},
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "it's"),
+				sqb.In("id", []byte("a"), nil),
+			},
+			args: []interface{}{true},
+			want: sqb.DisplayOnly + `SELECT * FROM t WHERE name = 'it''s' AND id IN ('\x61'::bytea, NULL) AND created = TRUE`,
+		},
+		{
+			name: "sqlserver with dedup",
+			opts: []sqb.Option{sqb.SetDialect(dialect.SQLServer{}), sqb.SetDedupArgs(true)},
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "a"),
+				sqb.In("id", 1, "a"),
+			},
+			args: []interface{}{false},
+			want: sqb.DisplayOnly + "SELECT * FROM t WHERE name = 'a' AND id IN (1, 'a') AND created = 0",
+		},
+		{
+			name: "arg appended before placeholder",
+			opts: []sqb.Option{sqb.SetDialect(dialect.PostgreSQL{})},
+			stmts: []stmt.Expr{
+				&ExprMock{
+					WriteMock: func(b stmt.Builder) error {
+						b.AppendArgs(42)
+						b.WriteString("y = ")
+						b.WritePlaceholder()
+						return nil
+					},
+				},
+				sqb.Eq("x", 5),
+			},
+			args: []interface{}{7},
+			want: sqb.DisplayOnly + "SELECT * FROM t WHERE y = 42 AND x = 5 AND created = 7",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(tt.opts...)
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			got, err := b.BindArgs(tt.args...).Interpolate("SELECT * FROM t WHERE ? AND ? AND created = ??")
+			if err != nil {
+				t.Fatalf("Builder.Interpolate() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuilder_Interpolate_Error(t *testing.T) {
+	b := sqb.New().Bind(sqb.Eq("a", 1)).Bind(sqb.Eq("b", struct{}{}))
+	_, err := b.Interpolate("SELECT * FROM t WHERE ? AND ?")
+	want := &stmt.BuildError{
+		Op:   "args[1]",
+		Slot: -1,
+		Type: "struct {}",
+		Err:  sqb.ErrUnsupportedLiteral,
+	}
+	if !reflect.DeepEqual(want, err) {
+		t.Errorf("Builder.Interpolate() error = %#v, want %#v", err, want)
+	}
+
+	_, err = sqb.New().Interpolate("SELECT * FROM t WHERE ?")
+	if !errors.Is(err, sqb.ErrMissingExpr) {
+		t.Errorf("Builder.Interpolate() error = %v, want %v", err, sqb.ErrMissingExpr)
+	}
+}
@@ -0,0 +1,112 @@
+package sqb
+
+import (
+	"database/sql"
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+// BuildNamed builds sql query string like Build, but returns the args as
+// a map keyed by the parameter names instead of a positional list.
+//
+// The keys match the placeholders written in the query without the mark,
+// e.g. "p1" for '@p1'. It can be used as spanner.Statement.Params.
+// The dialect should use named parameters such as dialect.Spanner,
+// dialect.SQLServer and dialect.Oracle. The prefix of the names is
+// configured by the dialect. Because the names should start with a letter
+// or an underscore, it returns an error which wraps ErrNamedParams if the
+// prefix is empty, such as dialect.Spanner{}.
+func (b *Builder) BuildNamed(baseQuery string) (string, map[string]interface{}, error) {
+	query, args, err := b.Build(baseQuery)
+	if err != nil {
+		return "", nil, err
+	}
+	params, err := NamedParams(b.dialect, args)
+	if err != nil {
+		return "", nil, err
+	}
+	return query, params, nil
+}
+
+// BuildNamedArgs builds sql query string like Build, but each arg is
+// converted to sql.NamedArg so that it can be passed to database/sql.
+//
+// The names match the placeholders written in the query without the mark,
+// e.g. "p1" for '@p1'. Unlike BuildNamed, the names should start with a
+// letter because database/sql rejects the others. See also BuildNamed.
+func (b *Builder) BuildNamedArgs(baseQuery string) (string, []interface{}, error) {
+	query, args, err := b.Build(baseQuery)
+	if err != nil {
+		return "", nil, err
+	}
+	named, err := NamedArgs(b.dialect, args)
+	if err != nil {
+		return "", nil, err
+	}
+	return query, named, nil
+}
+
+// NamedParams converts the args which are returned by Build or BuildTemplate
+// into a map keyed by the parameter names of the dialect.
+func NamedParams(d dialect.Dialect, args []interface{}) (map[string]interface{}, error) {
+	if err := checkNamedParams(d, isParamNameStart); err != nil {
+		return nil, err
+	}
+	params := make(map[string]interface{}, len(args))
+	for i, arg := range args {
+		name, _ := dialect.ParamName(d, i+1)
+		params[name] = arg
+	}
+	return params, nil
+}
+
+// NamedArgs converts the args which are returned by Build or BuildTemplate
+// into sql.NamedArg list which are named by the dialect.
+func NamedArgs(d dialect.Dialect, args []interface{}) ([]interface{}, error) {
+	if err := checkNamedParams(d, unicode.IsLetter); err != nil {
+		return nil, err
+	}
+	named := make([]interface{}, len(args))
+	for i, arg := range args {
+		name, _ := dialect.ParamName(d, i+1)
+		named[i] = sql.Named(name, arg)
+	}
+	return named, nil
+}
+
+// isParamNameStart reports whether r can start a query parameter name of
+// Cloud Spanner.
+func isParamNameStart(r rune) bool {
+	return r == '_' || unicode.IsLetter(r)
+}
+
+// checkNamedParams checks the dialect writes named parameters whose first
+// rune is accepted by isStart.
+func checkNamedParams(d dialect.Dialect, isStart func(rune) bool) error {
+	if d == nil {
+		d = dialect.MySQL{}
+	}
+	name, ok := dialect.ParamName(d, 1)
+	if !ok {
+		return namedParamsError(d, ErrNamedParams)
+	}
+	// Both Cloud Spanner and database/sql reject the names which start
+	// with a digit, such as "1" of dialect.Spanner{}.
+	if r, _ := utf8.DecodeRuneInString(name); !isStart(r) {
+		return namedParamsError(d, fmt.Errorf("%w: parameter name %q has an invalid first character, set the prefix of the dialect", ErrNamedParams, name))
+	}
+	return nil
+}
+
+func namedParamsError(d dialect.Dialect, err error) *stmt.BuildError {
+	return &stmt.BuildError{
+		Op:   "args",
+		Slot: -1,
+		Type: fmt.Sprintf("%T", d),
+		Err:  err,
+	}
+}
@@ -0,0 +1,159 @@
+package sqb_test
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestBuilder_BuildNamed(t *testing.T) {
+	tests := []struct {
+		name       string
+		dialect    dialect.Dialect
+		want       string
+		wantParams map[string]interface{}
+	}{
+		{
+			name:    "spanner",
+			dialect: dialect.Spanner{Prefix: "p"},
+			want:    "SELECT * FROM tables WHERE id = @p1 AND category IN (@p2, @p3)",
+			wantParams: map[string]interface{}{
+				"p1": 10,
+				"p2": 1,
+				"p3": 2,
+			},
+		},
+		{
+			name:    "sqlserver",
+			dialect: dialect.SQLServer{},
+			want:    "SELECT * FROM tables WHERE id = @p1 AND category IN (@p2, @p3)",
+			wantParams: map[string]interface{}{
+				"p1": 10,
+				"p2": 1,
+				"p3": 2,
+			},
+		},
+		{
+			name:    "oracle",
+			dialect: dialect.Oracle{Prefix: "v"},
+			want:    "SELECT * FROM tables WHERE id = :v1 AND category IN (:v2, :v3)",
+			wantParams: map[string]interface{}{
+				"v1": 10,
+				"v2": 1,
+				"v3": 2,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetDialect(tt.dialect)).
+				Bind(sqb.In("category", 1, 2)).
+				BindArgs(10)
+			got, params, err := b.BuildNamed("SELECT * FROM tables WHERE id = ?? AND ?")
+			if err != nil {
+				t.Fatalf("Builder.BuildNamed() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantParams, params); diff != "" {
+				t.Errorf("params (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilder_BuildNamedArgs(t *testing.T) {
+	b := sqb.New(sqb.SetDialect(dialect.SQLServer{Prefix: "arg"})).
+		Bind(sqb.In("category", 1, 2))
+	got, args, err := b.BuildNamedArgs("SELECT * FROM tables WHERE ?")
+	if err != nil {
+		t.Fatalf("Builder.BuildNamedArgs() error = %v", err)
+	}
+	want := "SELECT * FROM tables WHERE category IN (@arg1, @arg2)"
+	if got != want {
+		t.Errorf("sql\ngot = %q\nwant %q", got, want)
+	}
+	wantArgs := []interface{}{
+		sql.Named("arg1", 1),
+		sql.Named("arg2", 2),
+	}
+	if diff := cmp.Diff(wantArgs, args, cmpopts.IgnoreUnexported(sql.NamedArg{})); diff != "" {
+		t.Errorf("args (-want, +got)\n%s", diff)
+	}
+}
+
+func TestBuilder_BuildNamed_Unsupported(t *testing.T) {
+	for _, d := range []dialect.Dialect{nil, dialect.MySQL{}, dialect.PostgreSQL{}, dialect.Spanner{}, dialect.Oracle{}} {
+		b := sqb.New(sqb.SetDialect(d)).Bind(sqb.Eq("id", 1))
+		if _, _, err := b.BuildNamed("SELECT * FROM tables WHERE ?"); !errors.Is(err, sqb.ErrNamedParams) {
+			t.Errorf("Builder.BuildNamed() error = %v, want %v", err, sqb.ErrNamedParams)
+		}
+		if _, _, err := b.BuildNamedArgs("SELECT * FROM tables WHERE ?"); !errors.Is(err, sqb.ErrNamedParams) {
+			t.Errorf("Builder.BuildNamedArgs() error = %v, want %v", err, sqb.ErrNamedParams)
+		}
+	}
+}
+
+func TestBuilder_BuildNamed_ZeroPrefix(t *testing.T) {
+	b := sqb.New(sqb.SetPlaceholder(sqb.AtMark)).Bind(sqb.Eq("id", 1))
+	_, _, err := b.BuildNamed("SELECT * FROM tables WHERE ?")
+	if !errors.Is(err, sqb.ErrNamedParams) {
+		t.Fatalf("Builder.BuildNamed() error = %v, want %v", err, sqb.ErrNamedParams)
+	}
+	var e *stmt.BuildError
+	if !errors.As(err, &e) || e.Type != "dialect.Spanner" {
+		t.Errorf("Builder.BuildNamed() error = %v, want type %q", err, "dialect.Spanner")
+	}
+
+	// SQLServer defaults the prefix to "p".
+	_, params, err := sqb.New(sqb.SetDialect(dialect.SQLServer{})).Bind(sqb.Eq("id", 1)).
+		BuildNamed("SELECT * FROM tables WHERE ?")
+	if err != nil {
+		t.Fatalf("Builder.BuildNamed() error = %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"p1": 1}, params); diff != "" {
+		t.Errorf("params (-want, +got)\n%s", diff)
+	}
+}
+
+func TestBuilder_BuildNamed_UnderscorePrefix(t *testing.T) {
+	b := sqb.New(sqb.SetDialect(dialect.Spanner{Prefix: "_p"})).Bind(sqb.Eq("id", 1))
+	got, params, err := b.BuildNamed("SELECT * FROM tables WHERE ?")
+	if err != nil {
+		t.Fatalf("Builder.BuildNamed() error = %v", err)
+	}
+	if want := "SELECT * FROM tables WHERE id = @_p1"; got != want {
+		t.Errorf("sql\ngot = %q\nwant %q", got, want)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"_p1": 1}, params); diff != "" {
+		t.Errorf("params (-want, +got)\n%s", diff)
+	}
+
+	// database/sql requires the names to start with a letter.
+	if _, _, err := b.BuildNamedArgs("SELECT * FROM tables WHERE ?"); !errors.Is(err, sqb.ErrNamedParams) {
+		t.Errorf("Builder.BuildNamedArgs() error = %v, want %v", err, sqb.ErrNamedParams)
+	}
+}
+
+func TestNamedParams_Template(t *testing.T) {
+	d := dialect.Spanner{Prefix: "p"}
+	tmpl := sqb.MustCompile("SELECT * FROM tables WHERE ?", sqb.SetDialect(d))
+	_, args, err := sqb.New(sqb.SetDialect(d)).Bind(sqb.Eq("id", 1)).BuildTemplate(tmpl)
+	if err != nil {
+		t.Fatalf("Builder.BuildTemplate() error = %v", err)
+	}
+	params, err := sqb.NamedParams(d, args)
+	if err != nil {
+		t.Fatalf("NamedParams() error = %v", err)
+	}
+	if diff := cmp.Diff(map[string]interface{}{"p1": 1}, params); diff != "" {
+		t.Errorf("params (-want, +got)\n%s", diff)
+	}
+}
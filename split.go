@@ -0,0 +1,172 @@
+package sqb
+
+import (
+	"fmt"
+
+	"github.com/Code-Hex/sqb/internal/slice"
+	"github.com/Code-Hex/sqb/stmt"
+)
+
+// Query represents the built sql query string and the arg list.
+type Query struct {
+	SQL  string
+	Args []interface{}
+}
+
+// BuildSplit builds sql query strings like Build, but the IN which has more
+// than size values is split into multiple queries. Each query has a chunk
+// of the values which has at most size values, so it does not exceed
+// the limit of the number of parameters. The results of the queries should
+// be merged by the caller.
+//
+// Only one IN can be split. If the IN is placed under Or or Not, or it is NOT IN,
+// BuildSplit returns an error which wraps ErrUnsafeSplit because splitting
+// changes the meaning of the query. If there are no IN which has more than
+// size values, it returns a single query.
+func (b *Builder) BuildSplit(baseQuery string, size int) ([]Query, error) {
+	if size < 1 {
+		return nil, &stmt.BuildError{
+			Op:   "split",
+			Slot: -1,
+			Err:  fmt.Errorf("%w: %d", ErrSplitSize, size),
+		}
+	}
+	var found []splitTarget
+	for i, expr := range b.stmt {
+		found = findSplit(found, slotOp(i), expr, size, "")
+	}
+	for _, n := range b.named {
+		found = findSplit(found, namedOp(n.name), n.expr, size, "")
+	}
+	switch len(found) {
+	case 0:
+		query, args, err := b.Build(baseQuery)
+		if err != nil {
+			return nil, err
+		}
+		return []Query{{SQL: query, Args: args}}, nil
+	case 1:
+	default:
+		return nil, splitError(found[1], ErrMultipleSplit)
+	}
+
+	target := found[0]
+	if target.unsafe != "" {
+		return nil, splitError(target, fmt.Errorf("%w: under %s", ErrUnsafeSplit, target.unsafe))
+	}
+	queries := make([]Query, 0, (len(target.values)+size-1)/size)
+	for start := 0; start < len(target.values); start += size {
+		end := start + size
+		if end > len(target.values) {
+			end = len(target.values)
+		}
+		chunk := *target.in
+		chunk.Values = target.values[start:end:end]
+		query, args, err := b.replaceIn(target.in, &chunk).Build(baseQuery)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, Query{SQL: query, Args: args})
+	}
+	return queries, nil
+}
+
+// splitTarget represents the IN which is split.
+type splitTarget struct {
+	op     string
+	in     *stmt.CompIn
+	values []interface{}
+	// unsafe is the name of the node which the IN is placed under and
+	// which makes splitting unsafe, such as "Or".
+	unsafe string
+}
+
+func splitError(t splitTarget, err error) *stmt.BuildError {
+	return &stmt.BuildError{
+		Op:   t.op,
+		Slot: -1,
+		Type: fmt.Sprintf("%T", t.in),
+		Err:  err,
+	}
+}
+
+// findSplit walks expr and appends the IN which has more than size values
+// to found.
+func findSplit(found []splitTarget, op string, expr stmt.Expr, size int, unsafe string) []splitTarget {
+	switch e := expr.(type) {
+	case *stmt.And:
+		found = findSplit(found, op+".And.Left", e.Left, size, unsafe)
+		return findSplit(found, op+".And.Right", e.Right, size, unsafe)
+	case *stmt.Or:
+		if unsafe == "" {
+			unsafe = "Or"
+		}
+		found = findSplit(found, op+".Or.Left", e.Left, size, unsafe)
+		return findSplit(found, op+".Or.Right", e.Right, size, unsafe)
+	case *stmt.Paren:
+		return findSplit(found, op+".Paren", e.Expr, size, unsafe)
+	case *stmt.Not:
+		if unsafe == "" {
+			unsafe = "Not"
+		}
+		return findSplit(found, op+".Not", e.Expr, size, unsafe)
+	case *stmt.Condition:
+		in, ok := e.Compare.(*stmt.CompIn)
+		if !ok {
+			return found
+		}
+		values := slice.Flatten(in.Values)
+		if len(values) <= size {
+			return found
+		}
+		if in.Negative && unsafe == "" {
+			unsafe = "NOT"
+		}
+		return append(found, splitTarget{
+			op:     op + "." + e.Op(),
+			in:     in,
+			values: values,
+			unsafe: unsafe,
+		})
+	}
+	return found
+}
+
+// replaceIn returns copied *Builder which target is replaced with repl.
+// The bound expressions are copied only on the path to target.
+func (b *Builder) replaceIn(target, repl *stmt.CompIn) *Builder {
+	ret := *b
+	ret.stmt = make([]stmt.Expr, len(b.stmt))
+	for i, expr := range b.stmt {
+		ret.stmt[i] = replaceIn(expr, target, repl)
+	}
+	ret.named = make([]namedExpr, len(b.named))
+	for i, n := range b.named {
+		ret.named[i] = namedExpr{
+			name: n.name,
+			expr: replaceIn(n.expr, target, repl),
+		}
+	}
+	return &ret
+}
+
+func replaceIn(expr stmt.Expr, target, repl *stmt.CompIn) stmt.Expr {
+	switch e := expr.(type) {
+	case *stmt.And:
+		left, right := replaceIn(e.Left, target, repl), replaceIn(e.Right, target, repl)
+		if left != e.Left || right != e.Right {
+			return &stmt.And{Left: left, Right: right}
+		}
+	case *stmt.Paren:
+		if inner := replaceIn(e.Expr, target, repl); inner != e.Expr {
+			return &stmt.Paren{Expr: inner}
+		}
+	case *stmt.Condition:
+		if in, ok := e.Compare.(*stmt.CompIn); ok && in == target {
+			c := *e
+			c.Compare = repl
+			return &c
+		}
+	}
+	return expr
+}
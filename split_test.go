@@ -0,0 +1,138 @@
+package sqb_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/stmt"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuilder_BuildSplit(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder *sqb.Builder
+		sql     string
+		want    []sqb.Query
+	}{
+		{
+			name: "split",
+			builder: sqb.New().
+				Bind(sqb.And(sqb.Eq("a", "x"), sqb.In("id", []int{1, 2, 3, 4, 5}))).
+				Bind(sqb.In("category", 1, 2)),
+			sql: "SELECT * FROM t WHERE ? AND ?",
+			want: []sqb.Query{
+				{SQL: "SELECT * FROM t WHERE a = ? AND id IN (?, ?) AND category IN (?, ?)", Args: []interface{}{"x", 1, 2, 1, 2}},
+				{SQL: "SELECT * FROM t WHERE a = ? AND id IN (?, ?) AND category IN (?, ?)", Args: []interface{}{"x", 3, 4, 1, 2}},
+				{SQL: "SELECT * FROM t WHERE a = ? AND id IN (?) AND category IN (?, ?)", Args: []interface{}{"x", 5, 1, 2}},
+			},
+		},
+		{
+			name: "named and paren",
+			builder: sqb.New(sqb.SetDialect(dialect.Spanner{Prefix: "p"})).
+				BindNamed("where", sqb.Paren(sqb.In("id", 1, 2, 3))),
+			sql: "SELECT * FROM t WHERE {{where}} AND 1 = 1",
+			want: []sqb.Query{
+				{SQL: "SELECT * FROM t WHERE (id IN (@p1, @p2)) AND 1 = 1", Args: []interface{}{1, 2}},
+				{SQL: "SELECT * FROM t WHERE (id IN (@p1)) AND 1 = 1", Args: []interface{}{3}},
+			},
+		},
+		{
+			name: "left expression",
+			builder: sqb.New().
+				Bind(sqb.WithLeft(sqb.Func("LOWER", sqb.Column("code")), sqb.In("", "a", "b", "c"))),
+			sql: "SELECT * FROM t WHERE ?",
+			want: []sqb.Query{
+				{SQL: "SELECT * FROM t WHERE LOWER(code) IN (?, ?)", Args: []interface{}{"a", "b"}},
+				{SQL: "SELECT * FROM t WHERE LOWER(code) IN (?)", Args: []interface{}{"c"}},
+			},
+		},
+		{
+			name: "not split",
+			builder: sqb.New().
+				Bind(sqb.Or(sqb.In("id", 1, 2), sqb.NotIn("category", 1))),
+			sql: "SELECT * FROM t WHERE ?",
+			want: []sqb.Query{
+				{SQL: "SELECT * FROM t WHERE (id IN (?, ?) OR category NOT IN (?))", Args: []interface{}{1, 2, 1}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.builder.BuildSplit(tt.sql, 2)
+			if err != nil {
+				t.Fatalf("Builder.BuildSplit() error = %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("queries (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilder_BuildSplit_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    stmt.Expr
+		wantOp  string
+		wantErr error
+	}{
+		{
+			name:    "under or",
+			expr:    sqb.And(sqb.Eq("a", 1), sqb.Or(sqb.Eq("b", 1), sqb.In("id", 1, 2, 3))),
+			wantOp:  "slot[0].And.Right.Or.Right.Condition(id)",
+			wantErr: sqb.ErrUnsafeSplit,
+		},
+		{
+			name:    "under not",
+			expr:    sqb.And(sqb.Eq("a", 1), sqb.Not(sqb.In("id", 1, 2, 3))),
+			wantOp:  "slot[0].And.Right.Not.Condition(id)",
+			wantErr: sqb.ErrUnsafeSplit,
+		},
+		{
+			name:    "not in",
+			expr:    sqb.NotIn("id", 1, 2, 3),
+			wantOp:  "slot[0].Condition(id)",
+			wantErr: sqb.ErrUnsafeSplit,
+		},
+		{
+			name:    "left expression",
+			expr:    sqb.Or(sqb.Eq("a", 1), sqb.WithLeft(sqb.Func("LOWER", sqb.Column("code")), sqb.In("", "a", "b", "c"))),
+			wantOp:  "slot[0].Or.Right.Condition(LOWER(code))",
+			wantErr: sqb.ErrUnsafeSplit,
+		},
+		{
+			name:    "multiple",
+			expr:    sqb.And(sqb.In("a", 1, 2, 3), sqb.In("b", 1, 2, 3)),
+			wantOp:  "slot[0].And.Right.Condition(b)",
+			wantErr: sqb.ErrMultipleSplit,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sqb.New().Bind(tt.expr).BuildSplit("SELECT * FROM t WHERE ?", 2)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Builder.BuildSplit() error = %v, want %v", err, tt.wantErr)
+			}
+			var e *stmt.BuildError
+			if !errors.As(err, &e) || e.Op != tt.wantOp {
+				t.Errorf("Builder.BuildSplit() error = %v, want op %q", err, tt.wantOp)
+			}
+		})
+	}
+
+	_, err := sqb.New().BuildSplit("SELECT 1", 0)
+	if !errors.Is(err, sqb.ErrSplitSize) {
+		t.Errorf("Builder.BuildSplit() error = %v, want %v", err, sqb.ErrSplitSize)
+	}
+	var e *stmt.BuildError
+	if !errors.As(err, &e) || e.Op != "split" {
+		t.Errorf("Builder.BuildSplit() error = %v, want op %q", err, "split")
+	}
+	_, err = sqb.New().Bind(sqb.In("id", 1, 2, 3)).BuildSplit("SELECT * FROM t WHERE ? AND ?", 2)
+	if !errors.Is(err, sqb.ErrMissingExpr) {
+		t.Errorf("Builder.BuildSplit() error = %v, want %v", err, sqb.ErrMissingExpr)
+	}
+}
@@ -1,14 +1,16 @@
 package sqb
 
 import (
-	"errors"
-	"strings"
+	"fmt"
+	"strconv"
 
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/internal/lexer"
 	"github.com/Code-Hex/sqb/internal/pool"
 	"github.com/Code-Hex/sqb/stmt"
 )
 
-// There is build logic using placeholder in internal/pool.go.
+// These constants are used by SetPlaceholder.
 const (
 	// Question represents a '?' placeholder parameter.
 	Question = iota
@@ -24,16 +26,136 @@ type Option func(b *Builder)
 // SetPlaceholder sets placeholder.
 //
 // Default value is zero uses Question '?' as a placeholder.
+// This is a shortcut of SetDialect. Question uses dialect.MySQL,
+// Dollar uses dialect.PostgreSQL and AtMark uses dialect.Spanner.
 func SetPlaceholder(placeholder int) Option {
+	switch placeholder {
+	case Dollar:
+		return SetDialect(dialect.PostgreSQL{})
+	case AtMark:
+		return SetDialect(dialect.Spanner{})
+	default:
+		return SetDialect(dialect.MySQL{})
+	}
+}
+
+// SetDialect sets SQL dialect which is used to build sql query.
+//
+// Default value is dialect.MySQL. The dialect decides placeholders,
+// quoting rules of the base query and database specific syntax.
+func SetDialect(d dialect.Dialect) Option {
+	return func(b *Builder) {
+		b.dialect = d
+	}
+}
+
+// SetSlotMarker sets the marker of bindVar in the base query.
+//
+// Default value is "?". The doubled marker (e.g. "??") is written as
+// a placeholder of the database driver, and the value of it is bound by
+// BindArgs. If you use the operators contain '?' such as PostgreSQL's
+// JSONB operators "?", "?|" and "?&", you should change the marker
+// (e.g. "%s") so that '?' is written as it is.
+func SetSlotMarker(marker string) Option {
+	return func(b *Builder) {
+		b.marker = marker
+	}
+}
+
+// SetStrict sets whether Build returns an error when bound expressions
+// are left over.
+//
+// By default, the expressions which exceed bindVars are ignored.
+// In strict mode, it is treated as an error because it usually means
+// the condition never reached the query.
+func SetStrict(strict bool) Option {
+	return func(b *Builder) {
+		b.strict = strict
+	}
+}
+
+// SetDedupArgs sets whether the identical args reuse the same placeholder.
+//
+// By default, every arg is written as a new placeholder. When it is enabled,
+// Or(Eq("a", x), Eq("b", x)) is written as "a = $1 OR b = $1" and x is
+// bound only once. The args which are not comparable such as slices are
+// always bound as new placeholders. It has no effect if the dialect does not
+// use numbered placeholders such as '?'. See also dialect.Numbered.
+func SetDedupArgs(dedup bool) Option {
+	return func(b *Builder) {
+		b.dedup = dedup
+	}
+}
+
+// SetInBucket sets the BucketFunc which pads the list of every IN.
+//
+// By default, the list is not padded. The number of placeholders of IN is
+// padded to the size which is returned by f, by repeating the last value.
+// e.g. stmt.PowerOfTwo limits the number of distinct queries to log2(n).
+// The Bucket field of stmt.CompIn takes precedence over it.
+func SetInBucket(f stmt.BucketFunc) Option {
+	return func(b *Builder) {
+		b.in.Bucket = f
+	}
+}
+
+// SetInArrayThreshold sets the number of values of IN which is written as
+// a single array parameter.
+//
+// By default, the array parameter is not used. If the list has at least n
+// values and the dialect supports array parameters such as
+// dialect.PostgreSQL, IN is written as "= ANY($1)" and NOT IN is written
+// as "<> ALL($1)". It avoids the limit of the number of parameters.
+// The other dialects ignore it. See also stmt.CompIn.
+func SetInArrayThreshold(n int) Option {
 	return func(b *Builder) {
-		b.placeholder = placeholder
+		b.in.ArrayThreshold = n
+	}
+}
+
+// SetEmptyIn sets how the IN which has no values is written.
+//
+// Default value is stmt.EmptyInError which returns stmt.ErrEmptyIn.
+// stmt.EmptyInPredicate writes In with no values as the predicate which is
+// always false such as "1=0", and NotIn with no values as the predicate
+// which is always true such as "1=1". The predicates are decided by
+// the dialect. See also dialect.Predicate.
+func SetEmptyIn(policy stmt.EmptyInPolicy) Option {
+	return func(b *Builder) {
+		b.in.EmptyIn = policy
+	}
+}
+
+// SetNilAsNull sets whether nil values are compared as NULL.
+//
+// By default, nil is bound as an arg, so Eq("deleted_at", nil) is written
+// as "deleted_at = ?" which never matches. When it is enabled, Eq and Ne
+// with nil are written as "IS NULL" and "IS NOT NULL", and In with nil
+// in the list is written as "(column IN (...) OR column IS NULL)".
+// See also IsNull and IsNotNull.
+func SetNilAsNull(nilAsNull bool) Option {
+	return func(b *Builder) {
+		b.nilNull = nilAsNull
 	}
 }
 
 // Builder builds sql query string.
 type Builder struct {
-	placeholder int
-	stmt        []stmt.Expr
+	dialect dialect.Dialect
+	strict  bool
+	dedup   bool
+	in      stmt.InOptions
+	nilNull bool
+	marker  string
+	stmt    []stmt.Expr
+	named   []namedExpr
+	args    []interface{}
+}
+
+// namedExpr represents the expression which is bound by name.
+type namedExpr struct {
+	name string
+	expr stmt.Expr
 }
 
 // New returns sql query builder.
@@ -56,34 +178,270 @@ func (b *Builder) Bind(expr stmt.Expr) *Builder {
 	return &ret
 }
 
+// BindNamed binds expression to the named bindVar "{{name}}". returns
+// copied *Builder which bound expression.
+//
+// If the same name is bound more than once, Build returns an error.
+func (b *Builder) BindNamed(name string, expr stmt.Expr) *Builder {
+	ret := *b
+	// cap is limited so that append always copies the bound expressions.
+	ret.named = append(b.named[:len(b.named):len(b.named)], namedExpr{
+		name: name,
+		expr: expr,
+	})
+	return &ret
+}
+
+// BindArgs binds args to placeholders in the base query which are written
+// as the doubled bindVar "??". returns copied *Builder which bound args.
+//
+// The args are bound in order of appearance. The placeholders are numbered
+// together with the other placeholders when the dialect uses numbered
+// placeholders such as Dollar or AtMark.
+func (b *Builder) BindArgs(args ...interface{}) *Builder {
+	ret := *b
+	// cap is limited so that append always copies the bound args.
+	ret.args = append(b.args[:len(b.args):len(b.args)], args...)
+	return &ret
+}
+
+// Validate walks all bound expressions and reports every invalid node
+// without writing the query. It returns stmt.ValidationErrors if there are
+// invalid nodes, otherwise returns nil.
+//
+// The path of each error starts with the bindVar, such as "slot[1].And.Left"
+// or "{{where}}.Condition(category)". The expressions are validated with
+// the dialect and the options of the builder like Build. See also
+// stmt.ValidateWith.
+func (b *Builder) Validate() error {
+	// The expressions are validated with the settings which are used by
+	// Build. They are written into the same builder in order of the slots
+	// because some of them such as Limit depend on the preceding ones.
+	buf := pool.Get()
+	defer pool.Put(buf)
+	b.configure(buf)
+
+	var errs stmt.ValidationErrors
+	for i, expr := range b.stmt {
+		for _, err := range validate(buf, expr) {
+			e := stmt.WrapError(slotOp(i), expr, err)
+			e.Slot = i
+			errs = append(errs, e)
+		}
+	}
+	for i, n := range b.named {
+		if b.lookupNamed(n.name) != i {
+			errs = append(errs, namedError(n.name, n.expr, ErrDuplicateName))
+			continue
+		}
+		for _, err := range validate(buf, n.expr) {
+			errs = append(errs, stmt.WrapError(namedOp(n.name), n.expr, err))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+func validate(buf *pool.Builder, expr stmt.Expr) stmt.ValidationErrors {
+	errs, _ := stmt.ValidateWith(buf, expr).(stmt.ValidationErrors)
+	return errs
+}
+
 // Build builds sql query string, returning the built query string
 // and a new arg list that can be executed by a database. The `query` should
 // use the `?` bindVar. The return value uses the `?` bindVar.
+//
+// The base query can also use the named bindVar "{{name}}" which is
+// replaced with the expression bound by BindNamed. The same named bindVar
+// can be used more than once. The doubled bindVar `??` is written as
+// a placeholder, and the value bound by BindArgs is appended to the arg list.
+// The `?` and "{{name}}" which are placed in string literals, quoted
+// identifiers or comments are not treated as bindVar.
 func (b *Builder) Build(baseQuery string) (string, []interface{}, error) {
-	q := baseQuery
+	return b.build(lexer.New(baseQuery, b.marker, b.lexerRules()))
+}
 
+// tokenScanner is the interface that scans tokens of the base query.
+// It is implemented by *lexer.Scanner and *templateScanner.
+type tokenScanner interface {
+	Scan() bool
+	Token() lexer.Token
+	Err() error
+}
+
+func (b *Builder) build(s tokenScanner) (string, []interface{}, error) {
 	buf := pool.Get()
 	defer pool.Put(buf)
 
-	buf.Placeholder = b.placeholder
+	if err := b.write(buf, s); err != nil {
+		return "", nil, err
+	}
+	return buf.String(), buf.Args(), nil
+}
+
+// configure sets the settings of b into buf.
+func (b *Builder) configure(buf *pool.Builder) {
+	buf.SetDialect(b.dialect)
+	buf.SetDedupArgs(b.dedup && dialect.Numbered(buf.Dialect()))
+	buf.SetInOptions(b.in)
+	buf.SetNilAsNull(b.nilNull)
+}
+
+// write writes the query which is scanned by s into buf.
+func (b *Builder) write(buf *pool.Builder, s tokenScanner) error {
+	if err := b.checkNamed(); err != nil {
+		return err
+	}
+
+	b.configure(buf)
+
+	// '?' <- bindVar, '??' <- placeholder
+	var bindVars, placeholders int
+	// used[i] reports whether b.named[i] is used in the base query.
+	used := make([]bool, len(b.named))
+	for s.Scan() {
+		tok := s.Token()
+		switch tok.Kind {
+		case lexer.Text:
+			buf.WriteString(tok.Value)
+		case lexer.Slot:
+			if bindVars >= len(b.stmt) {
+				// If number of statements is less than bindVars, returns an error;
+				return slotError(bindVars, nil, ErrMissingExpr)
+			}
+			if err := b.stmt[bindVars].Write(buf); err != nil {
+				e := stmt.WrapError(slotOp(bindVars), b.stmt[bindVars], err)
+				e.Slot = bindVars
+				return e
+			}
+			bindVars++
+		case lexer.Placeholder:
+			if placeholders >= len(b.args) {
+				return placeholderError(placeholders, ErrMissingArg)
+			}
+			buf.WritePlaceholder()
+			buf.AppendArgs(b.args[placeholders])
+			placeholders++
+		case lexer.NamedSlot:
+			i := b.lookupNamed(tok.Name)
+			if i == -1 {
+				return namedError(tok.Name, nil, ErrMissingName)
+			}
+			if err := b.named[i].expr.Write(buf); err != nil {
+				return stmt.WrapError(namedOp(tok.Name), b.named[i].expr, err)
+			}
+			used[i] = true
+		}
+	}
+	if err := s.Err(); err != nil {
+		return queryError(err)
+	}
+	if placeholders < len(b.args) {
+		return placeholderError(placeholders, ErrUnusedArg)
+	}
+	if b.strict && bindVars < len(b.stmt) {
+		return slotError(bindVars, b.stmt[bindVars], ErrUnusedExpr)
+	}
+	for i, ok := range used {
+		if !ok {
+			return namedError(b.named[i].name, nil, ErrUnknownName)
+		}
+	}
+	if max := buf.Dialect().MaxParams(); max > 0 && len(buf.Args()) > max {
+		err := fmt.Errorf("%w: %d parameters exceed %d", ErrTooManyParams, len(buf.Args()), max)
+		return queryError(err)
+	}
+
+	return nil
+}
+
+func slotOp(i int) string {
+	return "slot[" + strconv.Itoa(i) + "]"
+}
+
+func namedOp(name string) string {
+	return "{{" + name + "}}"
+}
+
+// slotError returns an error which is occurred at the bindVar '?'.
+// The expr is the expression bound to the bindVar. It can be nil.
+func slotError(i int, expr stmt.Expr, err error) *stmt.BuildError {
+	return &stmt.BuildError{
+		Op:   slotOp(i),
+		Slot: i,
+		Type: typeName(expr),
+		Err:  err,
+	}
+}
+
+// namedError returns an error which is occurred at the named bindVar "{{name}}".
+// The expr is the expression bound to the name. It can be nil.
+func namedError(name string, expr stmt.Expr, err error) *stmt.BuildError {
+	return &stmt.BuildError{
+		Op:   namedOp(name),
+		Slot: -1,
+		Type: typeName(expr),
+		Err:  err,
+	}
+}
+
+// placeholderError returns an error which is occurred at the placeholder "??".
+func placeholderError(i int, err error) *stmt.BuildError {
+	return &stmt.BuildError{
+		Op:   "placeholder[" + strconv.Itoa(i) + "]",
+		Slot: -1,
+		Err:  err,
+	}
+}
+
+// queryError returns an error which is occurred while scanning the base query.
+func queryError(err error) *stmt.BuildError {
+	return &stmt.BuildError{
+		Op:   "query",
+		Slot: -1,
+		Err:  err,
+	}
+}
+
+// typeName returns the type name of expr. It returns empty
+// string if expr is nil.
+func typeName(expr stmt.Expr) string {
+	if expr == nil {
+		return ""
+	}
+	return fmt.Sprintf("%T", expr)
+}
 
-	// '?' <- bindVar
-	var bindVars, offset int
-	for i := strings.IndexByte(q, '?'); i != -1; i = strings.IndexByte(q, '?') {
-		if bindVars >= len(b.stmt) {
-			// If number of statements is less than bindVars, returns an error;
-			return "", nil, errors.New("number of bindVars exceeds replaceable statements")
+// checkNamed checks whether the same name is bound more than once.
+func (b *Builder) checkNamed() error {
+	for i, n := range b.named {
+		if n.expr == nil {
+			return namedError(n.name, nil, stmt.ErrNilOperand)
+		}
+		if b.lookupNamed(n.name) != i {
+			return namedError(n.name, n.expr, ErrDuplicateName)
 		}
+	}
+	return nil
+}
 
-		buf.WriteString(q[:i])
-		if err := b.stmt[bindVars].Write(buf); err != nil {
-			return "", nil, err
+// lookupNamed returns the index of the expression bound by name.
+// It returns -1 if not found.
+func (b *Builder) lookupNamed(name string) int {
+	for i, n := range b.named {
+		if n.name == name {
+			return i
 		}
-		bindVars++
-		offset += i + 1
-		q = baseQuery[offset:]
 	}
-	buf.WriteString(q)
+	return -1
+}
 
-	return buf.String(), buf.Args(), nil
+// lexerRules returns quoting rules which are used to scan the base query.
+func (b *Builder) lexerRules() lexer.Rules {
+	if b.dialect == nil {
+		return lexer.MySQL
+	}
+	return lexer.RulesOf(b.dialect.Name())
 }
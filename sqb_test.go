@@ -5,8 +5,10 @@ import (
 	"testing"
 
 	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/dialect"
 	"github.com/Code-Hex/sqb/stmt"
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 )
 
 func TestBuilder_Build(t *testing.T) {
@@ -119,6 +121,39 @@ func TestBuilder_Build(t *testing.T) {
 			wantArgs: []interface{}{1, 2, "apple", "sony", "google", "abc%"},
 			wantErr:  false,
 		},
+		{
+			name: "valid question in literals and comments",
+			sql:  "SELECT 'what?' AS q /* why? */ FROM tables WHERE ? -- how?",
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			want:     "SELECT 'what?' AS q /* why? */ FROM tables WHERE name = ? -- how?",
+			wantArgs: []interface{}{"taro"},
+			wantErr:  false,
+		},
+		{
+			name: "valid question in dollar quotes with postgresql",
+			sql:  "SELECT $$what?$$ FROM tables WHERE ?",
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.Dollar),
+			},
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			want:     "SELECT $$what?$$ FROM tables WHERE name = $1",
+			wantArgs: []interface{}{"taro"},
+			wantErr:  false,
+		},
+		{
+			name: "invalid unterminated literal",
+			sql:  "SELECT * FROM tables WHERE name = 'taro AND ?",
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
 		{
 			name:     "invalid bindVars exceeds replaceable statements",
 			sql:      "SELECT * FROM tables WHERE ?",
@@ -162,3 +197,1009 @@ func TestBuilder_Build(t *testing.T) {
 		})
 	}
 }
+
+func TestBuilder_BindNamed(t *testing.T) {
+	type named struct {
+		name string
+		expr stmt.Expr
+	}
+	tests := []struct {
+		name     string
+		sql      string
+		options  []sqb.Option
+		stmts    []stmt.Expr
+		named    []named
+		want     string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name: "valid named",
+			sql:  "SELECT * FROM tables WHERE {{where}} ORDER BY {{order}}",
+			named: []named{
+				{name: "order", expr: sqb.OrderBy("id", true)},
+				{name: "where", expr: sqb.Eq("name", "taro")},
+			},
+			want:     "SELECT * FROM tables WHERE name = ? ORDER BY id DESC",
+			wantArgs: []interface{}{"taro"},
+			wantErr:  false,
+		},
+		{
+			name: "valid mixed with bindVars",
+			sql:  "SELECT * FROM tables WHERE {{where}} AND ? ?",
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.Dollar),
+			},
+			stmts: []stmt.Expr{
+				sqb.Eq("category", 1),
+				sqb.Limit(10),
+			},
+			named: []named{
+				{name: "where", expr: sqb.Eq("name", "taro")},
+			},
+			want:     "SELECT * FROM tables WHERE name = $1 AND category = $2 LIMIT 10",
+			wantArgs: []interface{}{"taro", 1},
+			wantErr:  false,
+		},
+		{
+			name: "valid same name twice",
+			sql:  "SELECT * FROM a WHERE {{where}} UNION SELECT * FROM b WHERE {{where}}",
+			named: []named{
+				{name: "where", expr: sqb.Eq("name", "taro")},
+			},
+			want:     "SELECT * FROM a WHERE name = ? UNION SELECT * FROM b WHERE name = ?",
+			wantArgs: []interface{}{"taro", "taro"},
+			wantErr:  false,
+		},
+		{
+			name: "invalid missing",
+			sql:  "SELECT * FROM tables WHERE {{where}} ORDER BY {{order}}",
+			named: []named{
+				{name: "where", expr: sqb.Eq("name", "taro")},
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "invalid unknown",
+			sql:  "SELECT * FROM tables WHERE {{where}}",
+			named: []named{
+				{name: "where", expr: sqb.Eq("name", "taro")},
+				{name: "order", expr: sqb.OrderBy("id", true)},
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "invalid duplicate",
+			sql:  "SELECT * FROM tables WHERE {{where}}",
+			named: []named{
+				{name: "where", expr: sqb.Eq("name", "taro")},
+				{name: "where", expr: sqb.Eq("name", "hanako")},
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "invalid nil expression",
+			sql:  "SELECT * FROM tables WHERE {{where}}",
+			named: []named{
+				{name: "where", expr: nil},
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "invalid build error",
+			sql:  "SELECT * FROM tables WHERE {{where}}",
+			named: []named{
+				{
+					name: "where",
+					expr: &ExprMock{
+						WriteMock: func(stmt.Builder) error {
+							return errors.New("error")
+						},
+					},
+				},
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(tt.options...)
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			for _, n := range tt.named {
+				b = b.BindNamed(n.name, n.expr)
+			}
+			got, args, err := b.Build(tt.sql)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Builder.Build() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilder_BindArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		options  []sqb.Option
+		stmts    []stmt.Expr
+		args     []interface{}
+		want     string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name: "valid placeholder",
+			sql:  "SELECT * FROM tables WHERE tenant_id = ?? AND ?",
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			args:     []interface{}{100},
+			want:     "SELECT * FROM tables WHERE tenant_id = ? AND name = ?",
+			wantArgs: []interface{}{100, "taro"},
+			wantErr:  false,
+		},
+		{
+			name: "valid placeholder with postgresql",
+			sql:  "SELECT * FROM tables WHERE ? AND tenant_id = ?? AND ?",
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.Dollar),
+			},
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+				sqb.In("category", 1, 2),
+			},
+			args:     []interface{}{100},
+			want:     "SELECT * FROM tables WHERE name = $1 AND tenant_id = $2 AND category IN ($3, $4)",
+			wantArgs: []interface{}{"taro", 100, 1, 2},
+			wantErr:  false,
+		},
+		{
+			name: "valid placeholder with spanner",
+			sql:  "SELECT * FROM tables WHERE ? AND tenant_id = ??",
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.AtMark),
+			},
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			args:     []interface{}{100},
+			want:     "SELECT * FROM tables WHERE name = @1 AND tenant_id = @2",
+			wantArgs: []interface{}{"taro", 100},
+			wantErr:  false,
+		},
+		{
+			name: "valid custom slot marker with jsonb operators",
+			sql:  "SELECT * FROM tables WHERE data ?| array['a', 'b'] AND data ? %s%s AND %s",
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.Dollar),
+				sqb.SetSlotMarker("%s"),
+			},
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			args:     []interface{}{"key"},
+			want:     "SELECT * FROM tables WHERE data ?| array['a', 'b'] AND data ? $1 AND name = $2",
+			wantArgs: []interface{}{"key", "taro"},
+			wantErr:  false,
+		},
+		{
+			name:     "invalid placeholders exceeds bound args",
+			sql:      "SELECT * FROM tables WHERE id = ?? AND tenant_id = ??",
+			args:     []interface{}{100},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid bound args exceeds placeholders",
+			sql:      "SELECT * FROM tables WHERE id = ??",
+			args:     []interface{}{100, 200},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(tt.options...)
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			got, args, err := b.BindArgs(tt.args...).Build(tt.sql)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Builder.Build() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		strict  bool
+		stmts   []stmt.Expr
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "not strict ignores unused expressions",
+			sql:    "SELECT * FROM tables WHERE ?",
+			strict: false,
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+				sqb.Eq("category", 1),
+			},
+			want: "SELECT * FROM tables WHERE name = ?",
+		},
+		{
+			name:   "strict without unused expressions",
+			sql:    "SELECT * FROM tables WHERE ?",
+			strict: true,
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			want: "SELECT * FROM tables WHERE name = ?",
+		},
+		{
+			name:   "strict with unused expressions",
+			sql:    "SELECT * FROM tables WHERE ? ORDER BY id",
+			strict: true,
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+				sqb.Limit(10),
+				sqb.Eq("category", 1),
+			},
+			wantErr: "slot[1]: unused expression (stmt.Limit)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetStrict(tt.strict))
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			builds := map[string]func() (string, []interface{}, error){
+				"Build": func() (string, []interface{}, error) {
+					return b.Build(tt.sql)
+				},
+				"BuildTemplate": func() (string, []interface{}, error) {
+					return b.BuildTemplate(sqb.MustCompile(tt.sql))
+				},
+			}
+			for name, build := range builds {
+				got, _, err := build()
+				if err != nil {
+					if err.Error() != tt.wantErr {
+						t.Errorf("%s() error = %q, wantErr %q", name, err, tt.wantErr)
+					}
+					continue
+				}
+				if tt.wantErr != "" {
+					t.Errorf("%s() want error %q", name, tt.wantErr)
+				}
+				if got != tt.want {
+					t.Errorf("%s()\ngot = %q\nwant %q", name, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBuilder_Build_BuildError(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		stmts   []stmt.Expr
+		named   map[string]stmt.Expr
+		want    *stmt.BuildError
+		wantErr error
+	}{
+		{
+			name: "path to the node",
+			sql:  "SELECT * FROM tables WHERE ? AND ?",
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+				sqb.And(
+					sqb.Eq("brand", "apple"),
+					sqb.Or(
+						sqb.In("category"),
+						sqb.Eq("category", 2),
+					),
+				),
+			},
+			want: &stmt.BuildError{
+				Op:   "slot[1].And.Right.Or.Left.Condition(category)",
+				Slot: 1,
+				Type: "*stmt.CompIn",
+			},
+			wantErr: stmt.ErrEmptyIn,
+		},
+		{
+			name: "nil operand",
+			sql:  "SELECT * FROM tables WHERE ?",
+			stmts: []stmt.Expr{
+				sqb.Paren(sqb.And(sqb.Eq("name", "taro"), nil)),
+			},
+			want: &stmt.BuildError{
+				Op:   "slot[0].Paren.And.Right",
+				Slot: 0,
+				Type: "*stmt.And",
+			},
+			wantErr: stmt.ErrNilOperand,
+		},
+		{
+			name: "named bindVar",
+			sql:  "SELECT * FROM tables WHERE {{where}}",
+			named: map[string]stmt.Expr{
+				"where": sqb.Between("price", nil, 100),
+			},
+			want: &stmt.BuildError{
+				Op:   "{{where}}.Condition(price).CompBetween.Left",
+				Slot: -1,
+				Type: "*stmt.CompBetween",
+			},
+			wantErr: stmt.ErrNilOperand,
+		},
+		{
+			name: "missing expression",
+			sql:  "SELECT * FROM tables WHERE ? AND ?",
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			want: &stmt.BuildError{
+				Op:   "slot[1]",
+				Slot: 1,
+			},
+			wantErr: sqb.ErrMissingExpr,
+		},
+		{
+			name: "missing name",
+			sql:  "SELECT * FROM tables WHERE {{where}} AND {{missing}}",
+			named: map[string]stmt.Expr{
+				"where": sqb.Eq("name", "taro"),
+			},
+			want: &stmt.BuildError{
+				Op:   "{{missing}}",
+				Slot: -1,
+			},
+			wantErr: sqb.ErrMissingName,
+		},
+		{
+			name: "unknown name",
+			sql:  "SELECT * FROM tables",
+			named: map[string]stmt.Expr{
+				"where": sqb.Eq("name", "taro"),
+			},
+			want: &stmt.BuildError{
+				Op:   "{{where}}",
+				Slot: -1,
+			},
+			wantErr: sqb.ErrUnknownName,
+		},
+		{
+			name: "unterminated literal",
+			sql:  "SELECT * FROM tables WHERE name = 'taro",
+			want: &stmt.BuildError{
+				Op:   "query",
+				Slot: -1,
+			},
+			wantErr: sqb.ErrUnterminated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New()
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			for name, expr := range tt.named {
+				b = b.BindNamed(name, expr)
+			}
+			_, _, err := b.Build(tt.sql)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Builder.Build() error = %v, want %v", err, tt.wantErr)
+			}
+			var got *stmt.BuildError
+			if !errors.As(err, &got) {
+				t.Fatalf("Builder.Build() error = %T, want *stmt.BuildError", err)
+			}
+			got.Err = nil
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("error (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilder_Validate(t *testing.T) {
+	b := sqb.New().
+		Bind(sqb.Eq("name", "taro")).
+		Bind(sqb.And(sqb.In("category"), nil)).
+		BindNamed("where", sqb.Between("price", nil, 100)).
+		BindNamed("order", sqb.OrderBy("id", false)).
+		BindNamed("order", sqb.OrderBy("id", true))
+
+	err := b.Validate()
+	errs, ok := err.(stmt.ValidationErrors)
+	if !ok {
+		t.Fatalf("Builder.Validate() error = %T, want stmt.ValidationErrors", err)
+	}
+	want := []string{
+		"slot[1].And.Left.Condition(category): it should be passed at least more than 1 (*stmt.CompIn)",
+		"slot[1].And.Right: unset operand (*stmt.And)",
+		"{{where}}.Condition(price).CompBetween.Left: unset operand (*stmt.CompBetween)",
+		"{{order}}: duplicate named bindVar (*stmt.OrderBy)",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("Builder.Validate() got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i := range want {
+		if got := errs[i].Error(); got != want[i] {
+			t.Errorf("errs[%d]\ngot = %q\nwant %q", i, got, want[i])
+		}
+	}
+	if errs[0].Slot != 1 || errs[2].Slot != -1 {
+		t.Errorf("unexpected slots: %d, %d", errs[0].Slot, errs[2].Slot)
+	}
+
+	if err := sqb.New().Bind(sqb.Eq("name", "taro")).Validate(); err != nil {
+		t.Errorf("Builder.Validate() error = %v", err)
+	}
+}
+
+func TestBuilder_Validate_Dialect(t *testing.T) {
+	arrayIn := &stmt.Condition{
+		Column:  "id",
+		Compare: &stmt.CompIn{Values: []interface{}{1, 2}, Array: true},
+	}
+	tests := []struct {
+		name    string
+		b       *sqb.Builder
+		sql     string
+		wantErr error
+	}{
+		{
+			name: "array in with postgresql",
+			b:    sqb.New(sqb.SetDialect(dialect.PostgreSQL{})).Bind(arrayIn),
+			sql:  "SELECT * FROM t WHERE ?",
+		},
+		{
+			name:    "array in with mysql",
+			b:       sqb.New(sqb.SetDialect(dialect.MySQL{})).Bind(arrayIn),
+			sql:     "SELECT * FROM t WHERE ?",
+			wantErr: stmt.ErrArrayUnsupported,
+		},
+		{
+			name: "limit with sqlserver",
+			b:    sqb.New(sqb.SetDialect(dialect.SQLServer{})).Bind(sqb.Limit(10)),
+			sql:  "SELECT * FROM t ORDER BY id ?",
+		},
+		{
+			name: "offset and limit with sqlserver",
+			b: sqb.New(sqb.SetDialect(dialect.SQLServer{})).
+				Bind(sqb.Offset(5)).
+				Bind(sqb.Limit(10)),
+			sql: "SELECT * FROM t ORDER BY id ?",
+		},
+		{
+			name: "limit and offset with sqlserver",
+			b: sqb.New(sqb.SetDialect(dialect.SQLServer{})).
+				Bind(sqb.Limit(10)).
+				Bind(sqb.Offset(5)),
+			sql:     "SELECT * FROM t ORDER BY id ? ?",
+			wantErr: stmt.ErrLimitOffsetRequired,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.b.Validate()
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Builder.Validate() error = %v", err)
+				}
+			} else if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Builder.Validate() error = %v, want %v", err, tt.wantErr)
+			}
+			_, _, err = tt.b.Build(tt.sql)
+			if (err != nil) != (tt.wantErr != nil) {
+				t.Errorf("Builder.Build() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		dialect  dialect.Dialect
+		stmts    []stmt.Expr
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "sqlite",
+			sql:     "SELECT * FROM ? WHERE [col?] = 1 AND ? ? ?",
+			dialect: dialect.SQLite{},
+			stmts: []stmt.Expr{
+				stmt.Ident("order"),
+				sqb.In("category", 1, 2),
+				sqb.Limit(10),
+				sqb.Offset(5),
+			},
+			want:     `SELECT * FROM "order" WHERE [col?] = 1 AND category IN (?, ?) LIMIT 10 LIMIT -1 OFFSET 5`,
+			wantArgs: []interface{}{1, 2},
+		},
+		{
+			name:    "sqlite numbered",
+			sql:     "SELECT * FROM t WHERE ? AND ?",
+			dialect: dialect.SQLite{Numbered: true},
+			stmts: []stmt.Expr{
+				sqb.In("category", 1, 2),
+				sqb.Eq("flag", true),
+			},
+			want:     `SELECT * FROM t WHERE category IN (?1, ?2) AND flag = ?3`,
+			wantArgs: []interface{}{1, 2, true},
+		},
+		{
+			name:    "postgresql",
+			sql:     "SELECT * FROM ? WHERE ?",
+			dialect: dialect.PostgreSQL{},
+			stmts: []stmt.Expr{
+				stmt.Ident("order"),
+				sqb.In("category", 1, 2),
+			},
+			want:     `SELECT * FROM "order" WHERE category IN ($1, $2)`,
+			wantArgs: []interface{}{1, 2},
+		},
+		{
+			name:    "sqlserver",
+			sql:     "SELECT * FROM ? WHERE [col]]?] = 1 AND ? ORDER BY id ?",
+			dialect: dialect.SQLServer{},
+			stmts: []stmt.Expr{
+				stmt.Ident("order"),
+				sqb.In("category", 1, 2),
+				sqb.LimitOffset{Limit: 10, Offset: 20},
+			},
+			want:     "SELECT * FROM [order] WHERE [col]]?] = 1 AND category IN (@p1, @p2) ORDER BY id OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY",
+			wantArgs: []interface{}{1, 2},
+		},
+		{
+			name:    "oracle",
+			sql:     "SELECT * FROM ? WHERE q'[col?]' = 'a' AND ? AND ? ORDER BY id ?",
+			dialect: dialect.Oracle{},
+			stmts: []stmt.Expr{
+				stmt.Ident("order"),
+				sqb.In("category", 1, 2),
+				sqb.Eq("name", ""),
+				sqb.LimitOffset{Limit: 10, Offset: 20},
+			},
+			want:     `SELECT * FROM "order" WHERE q'[col?]' = 'a' AND category IN (:1, :2) AND name IS NULL ORDER BY id OFFSET 20 ROWS FETCH FIRST 10 ROWS ONLY`,
+			wantArgs: []interface{}{1, 2},
+		},
+		{
+			name:    "nil uses mysql",
+			sql:     "SELECT * FROM ? WHERE ?",
+			dialect: nil,
+			stmts: []stmt.Expr{
+				stmt.Ident("order"),
+				sqb.In("category", 1, 2),
+			},
+			want:     "SELECT * FROM `order` WHERE category IN (?, ?)",
+			wantArgs: []interface{}{1, 2},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetDialect(tt.dialect))
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			got, args, err := b.Build(tt.sql)
+			if err != nil {
+				t.Fatalf("Builder.Build() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilder_Build_CombinedLimitOffset(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		stmts   []stmt.Expr
+		want    string
+		wantOp  string
+	}{
+		{
+			name:    "sqlserver limit",
+			dialect: dialect.SQLServer{},
+			stmts:   []stmt.Expr{sqb.Limit(10)},
+			want:    "SELECT * FROM tables ORDER BY id OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "sqlserver offset and limit",
+			dialect: dialect.SQLServer{},
+			stmts:   []stmt.Expr{sqb.Offset(5), sqb.Limit(10)},
+			want:    "SELECT * FROM tables ORDER BY id OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "sqlserver limit and offset",
+			dialect: dialect.SQLServer{},
+			stmts:   []stmt.Expr{sqb.Limit(10), sqb.Offset(5)},
+			wantOp:  "slot[1]",
+		},
+		{
+			name:    "oracle offset and limit",
+			dialect: dialect.Oracle{},
+			stmts:   []stmt.Expr{sqb.Offset(5), sqb.Limit(10)},
+			want:    "SELECT * FROM tables ORDER BY id OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "oracle limit and offset",
+			dialect: dialect.Oracle{},
+			stmts:   []stmt.Expr{sqb.Limit(10), sqb.Offset(5)},
+			wantOp:  "slot[1]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetDialect(tt.dialect))
+			base := "SELECT * FROM tables ORDER BY id"
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+				base += " ?"
+			}
+			got, _, err := b.Build(base)
+			if tt.wantOp != "" {
+				if !errors.Is(err, stmt.ErrLimitOffsetRequired) {
+					t.Fatalf("Builder.Build() error = %v, want %v", err, stmt.ErrLimitOffsetRequired)
+				}
+				var e *stmt.BuildError
+				if !errors.As(err, &e) || e.Op != tt.wantOp {
+					t.Errorf("Builder.Build() error = %v, want op %q", err, tt.wantOp)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Builder.Build() error = %v", err)
+			}
+			if tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestBuilder_Build_MaxParams(t *testing.T) {
+	values := make([]int, 2101)
+	b := sqb.New(sqb.SetDialect(dialect.SQLServer{})).Bind(sqb.In("id", values))
+	_, _, err := b.Build("SELECT * FROM tables WHERE ?")
+	if !errors.Is(err, sqb.ErrTooManyParams) {
+		t.Fatalf("Builder.Build() error = %v, want %v", err, sqb.ErrTooManyParams)
+	}
+
+	b = sqb.New(sqb.SetDialect(dialect.SQLServer{})).Bind(sqb.In("id", values[:2100]))
+	if _, _, err := b.Build("SELECT * FROM tables WHERE ?"); err != nil {
+		t.Fatalf("Builder.Build() error = %v", err)
+	}
+}
+
+func TestSetDedupArgs(t *testing.T) {
+	type key struct {
+		ID   int
+		Name string
+	}
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		stmts    []stmt.Expr
+		args     []interface{}
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "postgresql",
+			dialect: dialect.PostgreSQL{},
+			stmts: []stmt.Expr{
+				sqb.Or(sqb.Eq("a", 10), sqb.Eq("b", 10)),
+				sqb.In("c", 1, 10, 2, 1),
+			},
+			args:     []interface{}{2},
+			want:     "SELECT * FROM t WHERE (a = $1 OR b = $1) AND c IN ($2, $1, $3, $2) AND d = $3",
+			wantArgs: []interface{}{10, 1, 2},
+		},
+		{
+			name:    "spanner",
+			dialect: dialect.Spanner{Prefix: "p"},
+			stmts: []stmt.Expr{
+				sqb.Between("a", "x", "y"),
+				sqb.Ne("b", "y"),
+			},
+			args:     []interface{}{"x"},
+			want:     "SELECT * FROM t WHERE a BETWEEN @p1 AND @p2 AND b != @p2 AND d = @p1",
+			wantArgs: []interface{}{"x", "y"},
+		},
+		{
+			name:    "different types are not identical",
+			dialect: dialect.SQLServer{},
+			stmts: []stmt.Expr{
+				sqb.Eq("a", 1),
+				sqb.Eq("b", int64(1)),
+			},
+			args:     []interface{}{1},
+			want:     "SELECT * FROM t WHERE a = @p1 AND b = @p2 AND d = @p1",
+			wantArgs: []interface{}{1, int64(1)},
+		},
+		{
+			name:    "comparable structs and not comparable values",
+			dialect: dialect.SQLite{Numbered: true},
+			stmts: []stmt.Expr{
+				sqb.Eq("a", key{1, "a"}),
+				sqb.Eq("b", []byte("x")),
+			},
+			args:     []interface{}{[]byte("x")},
+			want:     "SELECT * FROM t WHERE a = ?1 AND b = ?2 AND d = ?3",
+			wantArgs: []interface{}{key{1, "a"}, []byte("x"), []byte("x")},
+		},
+		{
+			name:    "arg appended before placeholder",
+			dialect: dialect.PostgreSQL{},
+			stmts: []stmt.Expr{
+				&ExprMock{
+					WriteMock: func(b stmt.Builder) error {
+						b.AppendArgs(42)
+						b.WriteString("y = ")
+						b.WritePlaceholder()
+						return nil
+					},
+				},
+				sqb.Eq("x", 5),
+			},
+			args:     []interface{}{42},
+			want:     "SELECT * FROM t WHERE y = $1 AND x = $2 AND d = $1",
+			wantArgs: []interface{}{42, 5},
+		},
+		{
+			name:    "no effect on oracle",
+			dialect: dialect.Oracle{},
+			stmts: []stmt.Expr{
+				sqb.Or(sqb.Eq("a", 1), sqb.Eq("b", 1)),
+				sqb.Eq("c", 2),
+			},
+			args:     []interface{}{1},
+			want:     "SELECT * FROM t WHERE (a = :1 OR b = :2) AND c = :3 AND d = :4",
+			wantArgs: []interface{}{1, 1, 2, 1},
+		},
+		{
+			name:    "no effect on question",
+			dialect: dialect.MySQL{},
+			stmts: []stmt.Expr{
+				sqb.Eq("a", 1),
+				sqb.Eq("b", 1),
+			},
+			args:     []interface{}{1},
+			want:     "SELECT * FROM t WHERE a = ? AND b = ? AND d = ?",
+			wantArgs: []interface{}{1, 1, 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetDialect(tt.dialect), sqb.SetDedupArgs(true))
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			got, args, err := b.BindArgs(tt.args...).Build("SELECT * FROM t WHERE ? AND ? AND d = ??")
+			if err != nil {
+				t.Fatalf("Builder.Build() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetInBucket(t *testing.T) {
+	tests := []struct {
+		name     string
+		stmts    []stmt.Expr
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name: "padded",
+			stmts: []stmt.Expr{
+				sqb.In("a", 1, 2, 3),
+				sqb.NotIn("b", "x"),
+			},
+			want:     "SELECT * FROM t WHERE a IN ($1, $2, $3, $4) AND b NOT IN ($5)",
+			wantArgs: []interface{}{1, 2, 3, 3, "x"},
+		},
+		{
+			name: "compin takes precedence",
+			stmts: []stmt.Expr{
+				sqb.In("a", 1, 2, 3),
+				&stmt.Condition{
+					Column:  "b",
+					Compare: &stmt.CompIn{Values: []interface{}{"x"}, Bucket: stmt.Buckets(3)},
+				},
+			},
+			want:     "SELECT * FROM t WHERE a IN ($1, $2, $3, $4) AND b IN ($5, $6, $7)",
+			wantArgs: []interface{}{1, 2, 3, 3, "x", "x", "x"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(sqb.SetDialect(dialect.PostgreSQL{}), sqb.SetInBucket(stmt.PowerOfTwo))
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			got, args, err := b.Build("SELECT * FROM t WHERE ? AND ?")
+			if err != nil {
+				t.Fatalf("Builder.Build() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetInArrayThreshold(t *testing.T) {
+	ids := make([]int, 70000)
+	b := sqb.New(sqb.SetDialect(dialect.PostgreSQL{}), sqb.SetInArrayThreshold(100)).
+		Bind(sqb.In("id", ids)).
+		Bind(sqb.NotIn("category", 1, 2))
+	got, args, err := b.Build("SELECT * FROM t WHERE ? AND ?")
+	if err != nil {
+		t.Fatalf("Builder.Build() error = %v", err)
+	}
+	if want := "SELECT * FROM t WHERE id = ANY($1) AND category NOT IN ($2, $3)"; got != want {
+		t.Errorf("sql\ngot = %q\nwant %q", got, want)
+	}
+	if len(args) != 3 {
+		t.Fatalf("args = %d, want 3", len(args))
+	}
+	if arr, ok := args[0].(dialect.PostgreSQLArray); !ok || len(arr) != len(ids) {
+		t.Errorf("args[0] should be dialect.PostgreSQLArray with %d values", len(ids))
+	}
+}
+
+func TestSetNilAsNull(t *testing.T) {
+	var nilPtr *int
+	tests := []struct {
+		name     string
+		expr     stmt.Expr
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name: "eq",
+			expr: sqb.Eq("deleted_at", nil),
+			want: "deleted_at IS NULL",
+		},
+		{
+			name: "ne with nil pointer",
+			expr: sqb.Ne("deleted_at", nilPtr),
+			want: "deleted_at IS NOT NULL",
+		},
+		{
+			name:     "gt",
+			expr:     sqb.Gt("deleted_at", nil),
+			want:     "deleted_at > ?",
+			wantArgs: []interface{}{nil},
+		},
+		{
+			name:     "in",
+			expr:     sqb.And(sqb.Eq("a", 1), sqb.In("b", 1, nil, []interface{}{2, nil})),
+			want:     "a = ? AND (b IN (?, ?) OR b IS NULL)",
+			wantArgs: []interface{}{1, 1, 2},
+		},
+		{
+			name:     "not in",
+			expr:     sqb.NotIn("b", nil, 1),
+			want:     "(b NOT IN (?) AND b IS NOT NULL)",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name: "only nil",
+			expr: sqb.Or(sqb.In("b", nil), sqb.NotIn("c", nil)),
+			want: "(b IS NULL OR c IS NOT NULL)",
+		},
+		{
+			name:     "in without nil",
+			expr:     sqb.In("b", 1),
+			want:     "b IN (?)",
+			wantArgs: []interface{}{1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, args, err := sqb.New(sqb.SetNilAsNull(true)).Bind(tt.expr).Build("SELECT * FROM t WHERE ?")
+			if err != nil {
+				t.Fatalf("Builder.Build() error = %v", err)
+			}
+			if want := "SELECT * FROM t WHERE " + tt.want; got != want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+
+	got, args, err := sqb.New().Bind(sqb.Eq("deleted_at", nil)).Build("SELECT * FROM t WHERE ?")
+	if err != nil {
+		t.Fatalf("Builder.Build() error = %v", err)
+	}
+	if want := "SELECT * FROM t WHERE deleted_at = ?"; got != want || len(args) != 1 {
+		t.Errorf("sql = %q, args = %v, want %q with nil arg by default", got, args, want)
+	}
+}
+
+func TestSetEmptyIn(t *testing.T) {
+	b := sqb.New(sqb.SetDialect(dialect.SQLServer{}), sqb.SetEmptyIn(stmt.EmptyInPredicate)).
+		Bind(sqb.And(sqb.In("id", []int{}), sqb.Eq("a", 1))).
+		Bind(sqb.NotIn("category"))
+	if err := b.Validate(); err != nil {
+		t.Fatalf("Builder.Validate() error = %v", err)
+	}
+	got, args, err := b.Build("SELECT * FROM t WHERE ? AND ?")
+	if err != nil {
+		t.Fatalf("Builder.Build() error = %v", err)
+	}
+	if want := "SELECT * FROM t WHERE 1=0 AND a = @p1 AND 1=1"; got != want {
+		t.Errorf("sql\ngot = %q\nwant %q", got, want)
+	}
+	if diff := cmp.Diff([]interface{}{1}, args); diff != "" {
+		t.Errorf("args (-want, +got)\n%s", diff)
+	}
+
+	b = sqb.New(sqb.SetEmptyIn(stmt.EmptyInError)).Bind(sqb.In("id"))
+	if err := b.Validate(); !errors.Is(err, stmt.ErrEmptyIn) {
+		t.Errorf("Builder.Validate() error = %v, want %v", err, stmt.ErrEmptyIn)
+	}
+	if _, _, err := b.Build("SELECT * FROM t WHERE ?"); !errors.Is(err, stmt.ErrEmptyIn) {
+		t.Errorf("Builder.Build() error = %v, want %v", err, stmt.ErrEmptyIn)
+	}
+}
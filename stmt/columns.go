@@ -1,7 +1,5 @@
 package stmt
 
-import "errors"
-
 // Columns represents columns field.
 type Columns []string
 
@@ -10,7 +8,7 @@ type Columns []string
 func (c Columns) Write(b Builder) error {
 	switch len(c) {
 	case 0:
-		return errors.New("unspecified columns")
+		return newError("", c, ErrEmptyColumns)
 	case 1:
 		b.WriteString(c[0])
 		return nil
@@ -1,8 +1,7 @@
 package stmt
 
 import (
-	"errors"
-
+	"github.com/Code-Hex/sqb/dialect"
 	"github.com/Code-Hex/sqb/internal/slice"
 )
 
@@ -16,25 +15,58 @@ var (
 // CompOp represents condition for using operators.
 //
 // Op field should contain "=", ">=", ">", "<=", "<", "!=", "IS", "IS NOT"
-// Value field should set the value to use for comparison.
+// Value field should set the value to use for comparison. If Value
+// implements Expr such as Column, it is written as the expression instead
+// of a placeholder. e.g. "a.updated_at > b.synced_at".
+//
+// If the dialect treats an empty string as NULL such as Oracle, "=" with
+// an empty string Value is written as "IS NULL" and "!=" with it is
+// written as "IS NOT NULL".
+// "IS" and "IS NOT" with nil Value are written as "IS NULL" and "IS NOT NULL".
+// If the builder rewrites nil to NULL, "=" and "!=" with nil Value are
+// also written as them. See also NullBuilder.
 type CompOp struct {
 	Op    string
 	Value interface{}
 }
 
+// isNull reports whether Value should be compared as NULL.
+func (c *CompOp) isNull(b Builder) bool {
+	if isEmptyStringNull(b, c.Value) {
+		return true
+	}
+	if !isNil(c.Value) {
+		return false
+	}
+	return c.Op == "IS" || c.Op == "IS NOT" || IsNilAsNull(b)
+}
+
 // WriteComparison implemented Comparisoner interface.
 func (c *CompOp) WriteComparison(b Builder) error {
+	if c.isNull(b) {
+		switch c.Op {
+		case "=", "IS":
+			b.WriteString("IS NULL")
+			return nil
+		case "!=", "<>", "IS NOT":
+			b.WriteString("IS NOT NULL")
+			return nil
+		}
+	}
 	b.WriteString(c.Op)
 	b.WriteString(" ")
-	b.WritePlaceholder()
-	b.AppendArgs(c.Value)
-	return nil
+	return writeOperand(b, "CompOp.Value", c.Value)
 }
 
 // CompLike represents condition for using "LIKE".
 //
 // If enabled Negative field, it's meaning use "NOT LIKE".
-// Value field should set the value to use for comparison.
+// Value field should set the value to use for comparison. If Value
+// implements Expr, it is written as the expression like CompOp.
+//
+// If the dialect treats an empty string as NULL such as Oracle, "LIKE" with
+// an empty string Value is written as "IS NULL" and "NOT LIKE" with it is
+// written as "IS NOT NULL".
 type CompLike struct {
 	Negative bool
 	Value    interface{}
@@ -42,19 +74,26 @@ type CompLike struct {
 
 // WriteComparison implemented Comparisoner interface.
 func (c *CompLike) WriteComparison(b Builder) error {
+	if isEmptyStringNull(b, c.Value) {
+		if c.Negative {
+			b.WriteString("IS NOT NULL")
+		} else {
+			b.WriteString("IS NULL")
+		}
+		return nil
+	}
 	if c.Negative {
 		b.WriteString("NOT ")
 	}
 	b.WriteString("LIKE ")
-	b.WritePlaceholder()
-	b.AppendArgs(c.Value)
-	return nil
+	return writeOperand(b, "CompLike.Value", c.Value)
 }
 
 // CompBetween represents condition for using "BETWEEN".
 //
 // If enabled Negative field, it's meaning use "NOT BETWEEN".
 // This struct will convert to be like "BETWEEN left_expr AND right_expr".
+// If Left or Right implements Expr, it is written as the expression like CompOp.
 type CompBetween struct {
 	Negative bool
 	Left     interface{}
@@ -64,20 +103,20 @@ type CompBetween struct {
 // WriteComparison implemented Comparisoner interface.
 func (c *CompBetween) WriteComparison(b Builder) error {
 	if c.Left == nil {
-		return errors.New("unset Left Value in CompBetween")
+		return newError("CompBetween.Left", c, ErrNilOperand)
 	}
 	if c.Right == nil {
-		return errors.New("unset Right Value in CompBetween")
+		return newError("CompBetween.Right", c, ErrNilOperand)
 	}
 	if c.Negative {
 		b.WriteString("NOT ")
 	}
 	b.WriteString("BETWEEN ")
-	b.WritePlaceholder()
+	if err := writeOperand(b, "CompBetween.Left", c.Left); err != nil {
+		return err
+	}
 	b.WriteString(" AND ")
-	b.WritePlaceholder()
-	b.AppendArgs(c.Left, c.Right)
-	return nil
+	return writeOperand(b, "CompBetween.Right", c.Right)
 }
 
 // CompIn represents condition for using "IN".
@@ -85,31 +124,94 @@ func (c *CompBetween) WriteComparison(b Builder) error {
 // If enabled Negative field, it's meaning use "NOT IN".
 // Values field should set list to use for comparison.
 // This struct will convert to be like "IN (?, ?, ?)".
+// If the dialect treats an empty string as NULL such as Oracle, the empty
+// strings in the list are compared with "IS NULL" by Condition, e.g.
+// In("column", "", "x") is written as "(column IN (?) OR column IS NULL)".
+// If the builder writes the shape of the query, the list is collapsed
+// to "IN (?...)". See also ShapeBuilder.
+//
+// Bucket field pads the list up to the size which is returned by it, by
+// repeating the last value. e.g. PowerOfTwo writes 3 values as
+// "IN (?, ?, ?, ?)". If it is nil, InOptions.Bucket of the builder is used.
+//
+// If enabled Array field, the list is written as a single array parameter,
+// "= ANY(?)" or "<> ALL(?)" for "NOT IN". It returns an error if the
+// dialect does not support array parameters. The array parameter is also
+// used when the list has at least InOptions.ArrayThreshold values and
+// the dialect supports it. See also dialect.ArrayParam.
 type CompIn struct {
 	Negative bool
 	Values   []interface{}
+	Bucket   BucketFunc
+	Array    bool
 }
 
 // WriteComparison implemented Comparisoner interface.
 func (c *CompIn) WriteComparison(b Builder) error {
-	if c.Negative {
-		b.WriteString("NOT ")
-	}
-	b.WriteString("IN (")
 	args := slice.Flatten(c.Values)
+	if len(args) == 0 {
+		return newError("", c, ErrEmptyIn)
+	}
+	opts := InOptionsOf(b)
+	if IsShape(b) {
+		// The shape does not depend on the number of values.
+		c.writeIn(b)
+		b.WriteString(inShape + ")")
+		return nil
+	}
+	if c.Array || opts.ArrayThreshold > 0 && len(args) >= opts.ArrayThreshold {
+		if arr, ok := dialect.ArrayParam(DialectOf(b), args); ok {
+			if c.Negative {
+				b.WriteString("<> ALL(")
+			} else {
+				b.WriteString("= ANY(")
+			}
+			b.WritePlaceholder()
+			b.WriteString(")")
+			b.AppendArgs(arr)
+			return nil
+		}
+		if c.Array {
+			return newError("CompIn.Array", c, ErrArrayUnsupported)
+		}
+	}
+	bucket := c.Bucket
+	if bucket == nil {
+		bucket = opts.Bucket
+	}
+	args = pad(args, bucket)
+	c.writeIn(b)
 	if err := makePlaceholders(b, args); err != nil {
-		return err
+		return newError("", c, err)
 	}
 	b.WriteString(")")
 	b.AppendArgs(args...)
 	return nil
 }
 
+// writeIn writes "IN (" or "NOT IN (".
+func (c *CompIn) writeIn(b Builder) {
+	if c.Negative {
+		b.WriteString("NOT ")
+	}
+	b.WriteString("IN (")
+}
+
+// isEmptyStringNull reports whether v is an empty string and the dialect
+// of b treats it as NULL.
+func isEmptyStringNull(b Builder, v interface{}) bool {
+	s, ok := v.(string)
+	return ok && s == "" && dialect.EmptyStringIsNull(DialectOf(b))
+}
+
+// inShape is the collapsed list of placeholders which is written as the shape.
+const inShape = "?..."
+
 func makePlaceholders(b Builder, args []interface{}) error {
 	const sep = ", "
 	switch len(args) {
 	case 0:
-		return errors.New("it should be passed at least more than 1")
+		return ErrEmptyIn
 	case 1:
 		b.WritePlaceholder()
 		return nil
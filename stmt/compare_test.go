@@ -1,11 +1,13 @@
 package stmt
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/Code-Hex/sqb/dialect"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -213,6 +215,28 @@ func TestCompIn_WriteComparison(t *testing.T) {
 			wantArgs: []interface{}{1, 2, 3},
 			wantErr:  false,
 		},
+		{
+			name: "padded by bucket",
+			c: &CompIn{
+				Negative: true,
+				Values:   []interface{}{1, []int{2, 3}},
+				Bucket:   PowerOfTwo,
+			},
+			want:     "NOT IN (?, ?, ?, ?)",
+			wantArgs: []interface{}{1, 2, 3, 3},
+			wantErr:  false,
+		},
+		{
+			name: "bucket is smaller than values",
+			c: &CompIn{
+				Negative: false,
+				Values:   []interface{}{1, 2, 3},
+				Bucket:   Buckets(2),
+			},
+			want:     "IN (?, ?, ?)",
+			wantArgs: []interface{}{1, 2, 3},
+			wantErr:  false,
+		},
 		{
 			name: "invalid",
 			c: &CompIn{
@@ -223,6 +247,17 @@ func TestCompIn_WriteComparison(t *testing.T) {
 			wantArgs: []interface{}{},
 			wantErr:  true,
 		},
+		{
+			name: "invalid with bucket",
+			c: &CompIn{
+				Negative: false,
+				Values:   []interface{}{},
+				Bucket:   PowerOfTwo,
+			},
+			want:     "",
+			wantArgs: []interface{}{},
+			wantErr:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -299,3 +334,222 @@ func Test_makePlaceholders(t *testing.T) {
 		})
 	}
 }
+
+func TestCompOp_WriteComparison_Nil(t *testing.T) {
+	tests := []struct {
+		op   string
+		want string
+	}{
+		{op: "IS", want: "IS NULL"},
+		{op: "IS NOT", want: "IS NOT NULL"},
+		{op: "=", want: "= ?"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.op, func(t *testing.T) {
+			b := new(BuildCapture)
+			c := &CompOp{Op: tt.op, Value: nil}
+			if err := c.WriteComparison(b); err != nil {
+				t.Fatalf("CompOp.WriteComparison() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("CompOp.WriteComparison() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompIn_WriteComparison_Shape(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *CompIn
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "single value",
+			c:    &CompIn{Values: []interface{}{1}},
+			want: "IN (?...)",
+		},
+		{
+			name: "nested list",
+			c:    &CompIn{Negative: true, Values: []interface{}{[]int{1, 2}, 3}},
+			want: "NOT IN (?...)",
+		},
+		{
+			name:    "empty",
+			c:       &CompIn{Values: []interface{}{}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := new(ShapeCapture)
+			if err := tt.c.WriteComparison(b); (err != nil) != tt.wantErr {
+				t.Fatalf("CompIn.WriteComparison() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("CompIn.WriteComparison() = %q, want %q", got, tt.want)
+			}
+			if len(b.Args) != 0 {
+				t.Errorf("args should be empty: %v", b.Args)
+			}
+		})
+	}
+}
+
+func TestCompIn_WriteComparison_Array(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        *CompIn
+		dialect  dialect.Dialect
+		in       InOptions
+		want     string
+		wantArgs []interface{}
+		wantErr  error
+	}{
+		{
+			name:     "explicit",
+			c:        &CompIn{Values: []interface{}{[]int{1, 2}, 3}, Array: true},
+			dialect:  dialect.PostgreSQL{},
+			want:     "= ANY($1)",
+			wantArgs: []interface{}{dialect.PostgreSQLArray{1, 2, 3}},
+		},
+		{
+			name:     "explicit NOT IN",
+			c:        &CompIn{Negative: true, Values: []interface{}{1}, Array: true},
+			dialect:  dialect.PostgreSQL{},
+			want:     "<> ALL($1)",
+			wantArgs: []interface{}{dialect.PostgreSQLArray{1}},
+		},
+		{
+			name:     "over threshold",
+			c:        &CompIn{Values: []interface{}{1, 2, 3}, Bucket: PowerOfTwo},
+			dialect:  dialect.PostgreSQL{},
+			in:       InOptions{ArrayThreshold: 3},
+			want:     "= ANY($1)",
+			wantArgs: []interface{}{dialect.PostgreSQLArray{1, 2, 3}},
+		},
+		{
+			name:     "under threshold",
+			c:        &CompIn{Values: []interface{}{1, 2}},
+			dialect:  dialect.PostgreSQL{},
+			in:       InOptions{ArrayThreshold: 3},
+			want:     "IN ($1, $2)",
+			wantArgs: []interface{}{1, 2},
+		},
+		{
+			name:     "threshold is ignored if unsupported",
+			c:        &CompIn{Values: []interface{}{1, 2, 3}},
+			dialect:  dialect.MySQL{},
+			in:       InOptions{ArrayThreshold: 1},
+			want:     "IN (?, ?, ?)",
+			wantArgs: []interface{}{1, 2, 3},
+		},
+		{
+			name:    "explicit but unsupported",
+			c:       &CompIn{Values: []interface{}{1}, Array: true},
+			dialect: dialect.MySQL{},
+			wantErr: ErrArrayUnsupported,
+		},
+		{
+			name:    "empty",
+			c:       &CompIn{Values: []interface{}{}, Array: true},
+			dialect: dialect.PostgreSQL{},
+			wantErr: ErrEmptyIn,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &InCapture{
+				DialectCapture: DialectCapture{dialect: tt.dialect},
+				in:             tt.in,
+			}
+			err := tt.c.WriteComparison(b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("CompIn.WriteComparison() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("CompIn.WriteComparison() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestEmptyStringAsNull(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        Comparisoner
+		dialect  dialect.Dialect
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "eq with oracle",
+			c:       &CompOp{Op: "=", Value: ""},
+			dialect: dialect.Oracle{},
+			want:    "IS NULL",
+		},
+		{
+			name:    "ne with oracle",
+			c:       &CompOp{Op: "!=", Value: ""},
+			dialect: dialect.Oracle{},
+			want:    "IS NOT NULL",
+		},
+		{
+			name:     "gt with oracle",
+			c:        &CompOp{Op: ">", Value: ""},
+			dialect:  dialect.Oracle{},
+			want:     "> :1",
+			wantArgs: []interface{}{""},
+		},
+		{
+			name:     "eq not empty with oracle",
+			c:        &CompOp{Op: "=", Value: "a"},
+			dialect:  dialect.Oracle{},
+			want:     "= :1",
+			wantArgs: []interface{}{"a"},
+		},
+		{
+			name:    "like with oracle",
+			c:       &CompLike{Value: ""},
+			dialect: dialect.Oracle{},
+			want:    "IS NULL",
+		},
+		{
+			name:    "not like with oracle",
+			c:       &CompLike{Negative: true, Value: ""},
+			dialect: dialect.Oracle{},
+			want:    "IS NOT NULL",
+		},
+		{
+			name:     "eq with mysql",
+			c:        &CompOp{Op: "=", Value: ""},
+			dialect:  dialect.MySQL{},
+			want:     "= ?",
+			wantArgs: []interface{}{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			if err := tt.c.WriteComparison(b); err != nil {
+				t.Fatalf("WriteComparison() error = %v", err)
+			}
+			if got := b.buf.String(); tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
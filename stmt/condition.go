@@ -1,7 +1,8 @@
 package stmt
 
 import (
-	"errors"
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/internal/slice"
 )
 
 var _ Expr = (*Condition)(nil)
@@ -10,8 +11,13 @@ var _ Expr = (*Condition)(nil)
 //
 // this struct creates "<column_name> <comparable_condition>"
 // <comparable_condition> indicates Comparisoner interface.
+//
+// If Left field is set, it is written instead of Column, such as
+// "LOWER(email) = ?". Column is a shortcut of the left side which is
+// written as it is.
 type Condition struct {
 	Column  string
+	Left    Expr
 	Compare Comparisoner
 }
 
@@ -25,10 +31,90 @@ type Condition struct {
 // category IN ("music", "video")
 // category NOT IN ("music", "video")
 func (c *Condition) Write(b Builder) error {
-	b.WriteString(c.Column)
+	if in, ok := c.Compare.(*CompIn); ok {
+		if ok, err := c.writeIn(b, in); ok {
+			return err
+		}
+	}
+	left := c.left()
 	if c.Compare == nil {
-		return errors.New("unset Compare in condition")
+		if err := writeLeft(b, left); err != nil {
+			return WrapError(c.Op(), c, err)
+		}
+		return newError(c.Op(), c, ErrNilOperand)
+	}
+	if cc, ok := c.Compare.(ColumnComparisoner); ok {
+		if err := cc.WriteColumnComparison(b, left); err != nil {
+			return WrapError(c.Op(), c.Compare, err)
+		}
+		return nil
+	}
+	if err := writeLeft(b, left); err != nil {
+		return WrapError(c.Op(), c, err)
 	}
 	b.WriteString(" ")
-	return c.Compare.WriteComparison(b)
+	if err := c.Compare.WriteComparison(b); err != nil {
+		return WrapError(c.Op(), c.Compare, err)
+	}
+	return nil
+}
+
+// writeIn writes the condition of IN which is not written as
+// "<column_name> <comparable_condition>". It reports false if the
+// condition should be written by CompIn.
+//
+// The IN which has no values is written as the predicate if
+// InOptions.EmptyIn is EmptyInPredicate. The IN which has nil values is
+// written with "IS NULL" if the builder compares nil values as NULL, and
+// so is the IN which has empty strings if the dialect treats them as NULL.
+func (c *Condition) writeIn(b Builder, in *CompIn) (bool, error) {
+	if InOptionsOf(b).EmptyIn == EmptyInPredicate && len(slice.Flatten(in.Values)) == 0 {
+		b.WriteString(dialect.Predicate(DialectOf(b), in.Negative))
+		return true, nil
+	}
+	if !IsNilAsNull(b) && !dialect.EmptyStringIsNull(DialectOf(b)) {
+		return false, nil
+	}
+	in, ok := in.splitNull(b)
+	if !ok {
+		return false, nil
+	}
+	if err := writeNullIn(b, c.left(), in); err != nil {
+		return true, WrapError(c.Op(), in, err)
+	}
+	return true, nil
+}
+
+// left returns the left side of the condition.
+func (c *Condition) left() Expr {
+	if c.Left != nil {
+		return c.Left
+	}
+	return column(c.Column)
+}
+
+// Op returns the name of the node which is used in the path of BuildError,
+// such as "Condition(category)" or "Condition(LOWER(email))" for Left.
+func (c *Condition) Op() string {
+	if c.Left != nil {
+		return "Condition(" + exprString(c.Left) + ")"
+	}
+	return "Condition(" + c.Column + ")"
+}
+
+// column is the left side of the condition which is written by the string
+// shortcut Condition.Column.
+type column string
+
+func (c column) Write(b Builder) error {
+	b.WriteString(string(c))
+	return nil
+}
+
+// writeLeft writes the left side of the condition.
+func writeLeft(b Builder, left Expr) error {
+	if err := left.Write(b); err != nil {
+		return WrapError("Left", left, err)
+	}
+	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/Code-Hex/sqb/dialect"
 	"github.com/google/go-cmp/cmp"
 )
 
@@ -29,6 +30,47 @@ func TestCondition_Write(t *testing.T) {
 			wantArgs: []interface{}{"taro"},
 			wantErr:  false,
 		},
+		{
+			name: "left expression",
+			c: &Condition{
+				Left:    &Func{Name: "LOWER", Args: []interface{}{Column("email")}},
+				Compare: &CompOp{Op: "=", Value: "a@example.com"},
+			},
+			want:     "LOWER(email) = ?",
+			wantArgs: []interface{}{"a@example.com"},
+			wantErr:  false,
+		},
+		{
+			name: "left expression between",
+			c: &Condition{
+				Column:  "ignored",
+				Left:    &Func{Name: "DATE", Args: []interface{}{Column("created_at")}},
+				Compare: &CompBetween{Left: "2020-01-01", Right: "2020-12-31"},
+			},
+			want:     "DATE(created_at) BETWEEN ? AND ?",
+			wantArgs: []interface{}{"2020-01-01", "2020-12-31"},
+			wantErr:  false,
+		},
+		{
+			name: "left expression with args",
+			c: &Condition{
+				Left:    &Arith{Op: "*", Left: Column("price"), Right: 2},
+				Compare: &CompOp{Op: ">", Value: 100},
+			},
+			want:     "(price * ?) > ?",
+			wantArgs: []interface{}{2, 100},
+			wantErr:  false,
+		},
+		{
+			name: "invalid left expression",
+			c: &Condition{
+				Left:    &Func{},
+				Compare: &CompOp{Op: "=", Value: 1},
+			},
+			want:     "",
+			wantArgs: []interface{}{},
+			wantErr:  true,
+		},
 		{
 			name: "invalid nil compare",
 			c: &Condition{
@@ -74,3 +116,171 @@ func TestCondition_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestCondition_Write_EmptyIn(t *testing.T) {
+	tests := []struct {
+		name    string
+		c       *Condition
+		dialect dialect.Dialect
+		policy  EmptyInPolicy
+		want    string
+		wantErr error
+	}{
+		{
+			name:    "error",
+			c:       &Condition{Column: "id", Compare: &CompIn{Values: []interface{}{}}},
+			dialect: dialect.MySQL{},
+			policy:  EmptyInError,
+			wantErr: ErrEmptyIn,
+		},
+		{
+			name:    "in with mysql",
+			c:       &Condition{Column: "id", Compare: &CompIn{Values: []interface{}{[]int{}}}},
+			dialect: dialect.MySQL{},
+			policy:  EmptyInPredicate,
+			want:    "FALSE",
+		},
+		{
+			name:    "not in with postgres",
+			c:       &Condition{Column: "id", Compare: &CompIn{Negative: true}},
+			dialect: dialect.PostgreSQL{},
+			policy:  EmptyInPredicate,
+			want:    "TRUE",
+		},
+		{
+			name:    "in with sqlserver",
+			c:       &Condition{Column: "id", Compare: &CompIn{}},
+			dialect: dialect.SQLServer{},
+			policy:  EmptyInPredicate,
+			want:    "1=0",
+		},
+		{
+			name:    "not in with oracle",
+			c:       &Condition{Column: "id", Compare: &CompIn{Negative: true}},
+			dialect: dialect.Oracle{},
+			policy:  EmptyInPredicate,
+			want:    "1=1",
+		},
+		{
+			name:    "not empty",
+			c:       &Condition{Column: "id", Compare: &CompIn{Values: []interface{}{1}}},
+			dialect: dialect.SQLite{},
+			policy:  EmptyInPredicate,
+			want:    "id IN (?)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &InCapture{
+				DialectCapture: DialectCapture{dialect: tt.dialect},
+				in:             InOptions{EmptyIn: tt.policy},
+			}
+			err := tt.c.Write(b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Condition.Write() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Condition.Write() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCondition_Write_Left(t *testing.T) {
+	left := &Func{Name: "LOWER", Args: []interface{}{Column("email")}}
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		compare Comparisoner
+		want    string
+	}{
+		{
+			name:    "distinct",
+			dialect: dialect.MySQL{},
+			compare: &CompDistinct{Value: "a"},
+			want:    "NOT (LOWER(email) <=> ?)",
+		},
+		{
+			name:    "in with null",
+			dialect: dialect.PostgreSQL{},
+			compare: &CompIn{Values: []interface{}{"a", nil}},
+			want:    "(LOWER(email) IN ($1) OR LOWER(email) IS NULL)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &NullCapture{DialectCapture: DialectCapture{dialect: tt.dialect}}
+			c := &Condition{Left: left, Compare: tt.compare}
+			if err := c.Write(b); err != nil {
+				t.Fatalf("Condition.Write() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Condition.Write() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	err := Validate(&Condition{Left: &Func{}, Compare: &CompOp{Op: "=", Value: 1}})
+	var errs ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 1 {
+		t.Fatalf("Validate() error = %v, want 1 error", err)
+	}
+	if want := "Condition(*stmt.Func).Left.Func.Name"; errs[0].Op != want {
+		t.Errorf("Validate() op = %q, want %q", errs[0].Op, want)
+	}
+}
+
+func TestCondition_Write_EmptyStringIn(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		c        *Condition
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "in",
+			dialect:  dialect.Oracle{},
+			c:        &Condition{Column: "c", Compare: &CompIn{Values: []interface{}{"", "x"}}},
+			want:     "(c IN (:1) OR c IS NULL)",
+			wantArgs: []interface{}{"x"},
+		},
+		{
+			name:     "not in",
+			dialect:  dialect.Oracle{},
+			c:        &Condition{Column: "c", Compare: &CompIn{Negative: true, Values: []interface{}{"x", ""}}},
+			want:     "(c NOT IN (:1) AND c IS NOT NULL)",
+			wantArgs: []interface{}{"x"},
+		},
+		{
+			name:    "only empty string",
+			dialect: dialect.Oracle{},
+			c:       &Condition{Column: "c", Compare: &CompIn{Values: []interface{}{""}}},
+			want:    "c IS NULL",
+		},
+		{
+			name:     "not oracle",
+			dialect:  dialect.PostgreSQL{},
+			c:        &Condition{Column: "c", Compare: &CompIn{Values: []interface{}{"", "x"}}},
+			want:     "c IN ($1, $2)",
+			wantArgs: []interface{}{"", "x"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			if err := tt.c.Write(b); err != nil {
+				t.Fatalf("Condition.Write() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Condition.Write() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,11 +1,10 @@
 package stmt
 
-import "errors"
-
 var (
 	_ Expr = (*Paren)(nil)
 	_ Expr = (*Or)(nil)
 	_ Expr = (*And)(nil)
+	_ Expr = (*Not)(nil)
 )
 
 // Paren represents a parenthesized expression.
@@ -16,11 +15,11 @@ type Paren struct {
 // Write writes the expression with parentheses.
 func (p *Paren) Write(b Builder) error {
 	if p.Expr == nil {
-		return errors.New("unset Expr in Paren")
+		return newError("Paren", p, ErrNilOperand)
 	}
 	b.WriteString("(")
 	if err := p.Expr.Write(b); err != nil {
-		return err
+		return WrapError("Paren", p.Expr, err)
 	}
 	b.WriteString(")")
 	return nil
@@ -37,18 +36,18 @@ type Or struct {
 // than AND on most of databases.
 func (o *Or) Write(b Builder) error {
 	if o.Left == nil {
-		return errors.New("unset Left Expr in OR")
+		return newError("Or.Left", o, ErrNilOperand)
 	}
 	if o.Right == nil {
-		return errors.New("unset Right Expr in OR")
+		return newError("Or.Right", o, ErrNilOperand)
 	}
 	b.WriteString("(")
 	if err := o.Left.Write(b); err != nil {
-		return err
+		return WrapError("Or.Left", o.Left, err)
 	}
 	b.WriteString(" OR ")
 	if err := o.Right.Write(b); err != nil {
-		return err
+		return WrapError("Or.Right", o.Right, err)
 	}
 	b.WriteString(")")
 	return nil
@@ -63,14 +62,47 @@ type And struct {
 // Write writes the AND boolean expression.
 func (a *And) Write(b Builder) error {
 	if a.Left == nil {
-		return errors.New("unset Left Expr in And")
+		return newError("And.Left", a, ErrNilOperand)
 	}
 	if a.Right == nil {
-		return errors.New("unset Right Expr in And")
+		return newError("And.Right", a, ErrNilOperand)
 	}
 	if err := a.Left.Write(b); err != nil {
-		return err
+		return WrapError("And.Left", a.Left, err)
 	}
 	b.WriteString(" AND ")
-	return a.Right.Write(b)
+	if err := a.Right.Write(b); err != nil {
+		return WrapError("And.Right", a.Right, err)
+	}
+	return nil
+}
+
+// Not represents a NOT boolean expression.
+//
+// The expression is written with parentheses such as "NOT (a = ?)".
+// See also Negate which pushes the negation down to the conditions.
+type Not struct {
+	Expr Expr
+}
+
+// Write writes the NOT boolean expression with parentheses.
+func (n *Not) Write(b Builder) error {
+	if n.Expr == nil {
+		return newError("Not", n, ErrNilOperand)
+	}
+	b.WriteString("NOT ")
+	// Paren and Or are already written with parentheses.
+	switch n.Expr.(type) {
+	case *Paren, *Or:
+		if err := n.Expr.Write(b); err != nil {
+			return WrapError("Not", n.Expr, err)
+		}
+		return nil
+	}
+	b.WriteString("(")
+	if err := n.Expr.Write(b); err != nil {
+		return WrapError("Not", n.Expr, err)
+	}
+	b.WriteString(")")
+	return nil
 }
@@ -296,3 +296,74 @@ func TestAnd_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestNot_Write(t *testing.T) {
+	cond := &Condition{Column: "a", Compare: &CompOp{Op: "=", Value: 1}}
+	tests := []struct {
+		name     string
+		n        *Not
+		want     string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name:     "valid",
+			n:        &Not{Expr: cond},
+			want:     "NOT (a = ?)",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name:     "paren",
+			n:        &Not{Expr: &Paren{Expr: cond}},
+			want:     "NOT (a = ?)",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name:     "or",
+			n:        &Not{Expr: &Or{Left: cond, Right: cond}},
+			want:     "NOT (a = ? OR a = ?)",
+			wantArgs: []interface{}{1, 1},
+		},
+		{
+			name:     "and",
+			n:        &Not{Expr: &And{Left: cond, Right: cond}},
+			want:     "NOT (a = ? AND a = ?)",
+			wantArgs: []interface{}{1, 1},
+		},
+		{
+			name:    "invalid nil",
+			n:       &Not{},
+			wantErr: true,
+		},
+		{
+			name: "invalid",
+			n: &Not{
+				Expr: &ExprMock{
+					WriteMock: func(b Builder) error {
+						return errors.New("error")
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &BuildCapture{
+				buf:  strings.Builder{},
+				Args: []interface{}{},
+			}
+			if err := tt.n.Write(b); (err != nil) != tt.wantErr {
+				t.Errorf("Not.Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				if got := b.buf.String(); tt.want != got {
+					t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+				}
+				if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+					t.Errorf("args (-want, +got)\n%s", diff)
+				}
+			}
+		})
+	}
+}
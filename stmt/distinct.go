@@ -0,0 +1,102 @@
+package stmt
+
+var (
+	_ Comparisoner       = (*CompDistinct)(nil)
+	_ ColumnComparisoner = (*CompDistinct)(nil)
+)
+
+// ColumnComparisoner is the interface that wraps WriteColumnComparison method.
+//
+// Condition uses it instead of WriteComparison to write the whole condition
+// which contains the column, such as "NOT (column <=> ?)". left is the left
+// side of the condition, which is the column or Condition.Left.
+type ColumnComparisoner interface {
+	Comparisoner
+	WriteColumnComparison(b Builder, left Expr) error
+}
+
+// CompDistinct represents null-safe comparison for using "IS DISTINCT FROM".
+//
+// If enabled Negative field, it's meaning use "IS NOT DISTINCT FROM".
+// Value field should set the value to use for comparison.
+//
+// It is written for the dialect of the builder:
+//
+//	PostgreSQL, Spanner and others: column IS [NOT] DISTINCT FROM ?
+//	MySQL:  NOT (column <=> ?), column <=> ?
+//	SQLite: column IS NOT ?, column IS ?
+//	Oracle: DECODE(column, ?, 0, 1) = 1, DECODE(column, ?, 0, 1) = 0
+type CompDistinct struct {
+	Negative bool
+	Value    interface{}
+}
+
+// WriteComparison implemented Comparisoner interface.
+//
+// It returns ErrColumnRequired if the dialect needs the column to write
+// the comparison such as MySQL and Oracle. Use Condition instead.
+func (c *CompDistinct) WriteComparison(b Builder) error {
+	switch DialectOf(b).Name() {
+	case "mysql":
+		if !c.Negative {
+			return newError("", c, ErrColumnRequired)
+		}
+		b.WriteString("<=> ")
+	case "sqlite":
+		if c.Negative {
+			b.WriteString("IS ")
+		} else {
+			b.WriteString("IS NOT ")
+		}
+	case "oracle":
+		return newError("", c, ErrColumnRequired)
+	default:
+		if c.Negative {
+			b.WriteString("IS NOT DISTINCT FROM ")
+		} else {
+			b.WriteString("IS DISTINCT FROM ")
+		}
+	}
+	return writeOperand(b, "CompDistinct.Value", c.Value)
+}
+
+// WriteColumnComparison implemented ColumnComparisoner interface.
+func (c *CompDistinct) WriteColumnComparison(b Builder, left Expr) error {
+	switch DialectOf(b).Name() {
+	case "mysql":
+		if c.Negative {
+			break
+		}
+		b.WriteString("NOT (")
+		if err := writeLeft(b, left); err != nil {
+			return err
+		}
+		b.WriteString(" <=> ")
+		if err := writeOperand(b, "CompDistinct.Value", c.Value); err != nil {
+			return err
+		}
+		b.WriteString(")")
+		return nil
+	case "oracle":
+		// DECODE treats NULLs as equal.
+		b.WriteString("DECODE(")
+		if err := writeLeft(b, left); err != nil {
+			return err
+		}
+		b.WriteString(", ")
+		if err := writeOperand(b, "CompDistinct.Value", c.Value); err != nil {
+			return err
+		}
+		if c.Negative {
+			b.WriteString(", 0, 1) = 0")
+		} else {
+			b.WriteString(", 0, 1) = 1")
+		}
+		return nil
+	}
+	if err := writeLeft(b, left); err != nil {
+		return err
+	}
+	b.WriteString(" ")
+	return c.WriteComparison(b)
+}
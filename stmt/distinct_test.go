@@ -0,0 +1,65 @@
+package stmt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompDistinct_WriteColumnComparison(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		negative bool
+		want     string
+	}{
+		{name: "postgres", dialect: dialect.PostgreSQL{}, want: "col IS DISTINCT FROM $1"},
+		{name: "postgres not", dialect: dialect.PostgreSQL{}, negative: true, want: "col IS NOT DISTINCT FROM $1"},
+		{name: "spanner", dialect: dialect.Spanner{}, want: "col IS DISTINCT FROM @1"},
+		{name: "spanner not", dialect: dialect.Spanner{}, negative: true, want: "col IS NOT DISTINCT FROM @1"},
+		{name: "mysql", dialect: dialect.MySQL{}, want: "NOT (col <=> ?)"},
+		{name: "mysql not", dialect: dialect.MySQL{}, negative: true, want: "col <=> ?"},
+		{name: "sqlite", dialect: dialect.SQLite{}, want: "col IS NOT ?"},
+		{name: "sqlite not", dialect: dialect.SQLite{}, negative: true, want: "col IS ?"},
+		{name: "sqlserver", dialect: dialect.SQLServer{}, want: "col IS DISTINCT FROM @p1"},
+		{name: "oracle", dialect: dialect.Oracle{}, want: "DECODE(col, :1, 0, 1) = 1"},
+		{name: "oracle not", dialect: dialect.Oracle{}, negative: true, want: "DECODE(col, :1, 0, 1) = 0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			c := &Condition{
+				Column:  "col",
+				Compare: &CompDistinct{Negative: tt.negative, Value: nil},
+			}
+			if err := c.Write(b); err != nil {
+				t.Fatalf("Condition.Write() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Condition.Write() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff([]interface{}{nil}, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompDistinct_WriteComparison(t *testing.T) {
+	b := &DialectCapture{dialect: dialect.PostgreSQL{}}
+	if err := (&CompDistinct{Value: 1}).WriteComparison(b); err != nil {
+		t.Fatalf("CompDistinct.WriteComparison() error = %v", err)
+	}
+	if want := "IS DISTINCT FROM $1"; b.buf.String() != want {
+		t.Errorf("CompDistinct.WriteComparison() = %q, want %q", b.buf.String(), want)
+	}
+
+	for _, d := range []dialect.Dialect{dialect.MySQL{}, dialect.Oracle{}} {
+		b := &DialectCapture{dialect: d}
+		if err := (&CompDistinct{Value: 1}).WriteComparison(b); !errors.Is(err, ErrColumnRequired) {
+			t.Errorf("CompDistinct.WriteComparison() error = %v, want %v", err, ErrColumnRequired)
+		}
+	}
+}
@@ -1,20 +1,67 @@
 package stmt
 
+import (
+	"errors"
+	"fmt"
+)
+
 var _ error = (*BuildError)(nil)
 
+// These errors are the causes of BuildError. You can match them with errors.Is.
+var (
+	// ErrNilOperand represents the operand of the expression is not set.
+	ErrNilOperand = errors.New("unset operand")
+	// ErrEmptyIn represents the values of IN expression are empty.
+	ErrEmptyIn = errors.New("it should be passed at least more than 1")
+	// ErrArrayUnsupported represents the dialect does not support array parameters.
+	ErrArrayUnsupported = errors.New("dialect does not support array parameters")
+	// ErrColumnRequired represents the comparison cannot be written without
+	// the column. See also ColumnComparisoner.
+	ErrColumnRequired = errors.New("comparison requires the column")
+	// ErrInvalidOperator represents the operator is not supported.
+	ErrInvalidOperator = errors.New("invalid operator")
+	// ErrMissingRawArg represents the marker of Raw is not bound to the arg.
+	ErrMissingRawArg = errors.New("number of markers exceeds args of Raw")
+	// ErrUnusedRawArg represents the arg of Raw is not used by the markers.
+	ErrUnusedRawArg = errors.New("number of args of Raw exceeds markers")
+	// ErrLimitOffsetRequired represents Limit and Offset cannot be written
+	// separately in the order by the dialect which writes them as a single
+	// clause such as SQL Server. Use LimitOffset instead.
+	ErrLimitOffsetRequired = errors.New("dialect requires LimitOffset instead of Limit followed by Offset")
+	// ErrEmptyColumns represents no columns are specified.
+	ErrEmptyColumns = errors.New("unspecified columns")
+	// ErrEmptyString represents the string is empty.
+	ErrEmptyString = errors.New("unspecified string")
+)
+
 // BuildError is the error type usually returned by functions in the stmt
 // package. It describes the current operation, occurred of
 // an error.
+//
+// Op is the path to the node which is occurred an error from the root
+// of the expression tree, such as "slot[1].And.Right.Or.Left.Condition(category)".
+// Slot is the index of the bindVar in the base query. It is -1 if the error
+// is not related to a bindVar '?'. Type is the type of the node which is
+// occurred an error, such as "*stmt.CompIn". Err is the cause of the error.
 type BuildError struct {
-	Op  string
-	Err error
+	Op   string
+	Slot int
+	Type string
+	Err  error
 }
 
 func (b *BuildError) Error() string {
 	if b == nil {
 		return "<nil>"
 	}
-	return b.Op + ": " + b.Err.Error()
+	msg := b.Err.Error()
+	if b.Op != "" {
+		msg = b.Op + ": " + msg
+	}
+	if b.Type != "" {
+		msg += " (" + b.Type + ")"
+	}
+	return msg
 }
 
 // Unwrap unwraps the wrapped error.
@@ -22,3 +69,52 @@ func (b *BuildError) Error() string {
 func (b *BuildError) Unwrap() error {
 	return b.Err
 }
+
+// WrapError returns *BuildError which is added op to the head of the path.
+//
+// If err is not *BuildError, it is wrapped as the cause which is occurred
+// at the node. This function is useful to implement an Expr which has child
+// expressions.
+func WrapError(op string, node interface{}, err error) *BuildError {
+	e, ok := err.(*BuildError)
+	if !ok {
+		return &BuildError{
+			Op:   op,
+			Slot: -1,
+			Type: typeName(node),
+			Err:  err,
+		}
+	}
+	ret := *e
+	ret.Op = joinOp(op, e.Op)
+	return &ret
+}
+
+// newError returns *BuildError which is occurred at the node.
+func newError(op string, node interface{}, err error) *BuildError {
+	return &BuildError{
+		Op:   op,
+		Slot: -1,
+		Type: typeName(node),
+		Err:  err,
+	}
+}
+
+func joinOp(parent, child string) string {
+	switch {
+	case parent == "":
+		return child
+	case child == "":
+		return parent
+	}
+	return parent + "." + child
+}
+
+// typeName returns the type name of node. It returns empty
+// string if node is nil.
+func typeName(node interface{}) string {
+	if node == nil {
+		return ""
+	}
+	return fmt.Sprintf("%T", node)
+}
@@ -2,6 +2,7 @@ package stmt
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 )
 
@@ -33,6 +34,22 @@ func TestBuildError_Error(t *testing.T) {
 			},
 			want: "ope: error",
 		},
+		{
+			name: "without op",
+			b: &BuildError{
+				Err: errors.New("error"),
+			},
+			want: "error",
+		},
+		{
+			name: "with type",
+			b: &BuildError{
+				Op:   "And.Left",
+				Type: "*stmt.And",
+				Err:  ErrNilOperand,
+			},
+			want: "And.Left: unset operand (*stmt.And)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -42,3 +59,68 @@ func TestBuildError_Error(t *testing.T) {
 		})
 	}
 }
+
+func TestWrapError(t *testing.T) {
+	cause := errors.New("error")
+	tests := []struct {
+		name string
+		op   string
+		node interface{}
+		err  error
+		want *BuildError
+	}{
+		{
+			name: "not BuildError",
+			op:   "And.Left",
+			node: &ExprMock{},
+			err:  cause,
+			want: &BuildError{
+				Op:   "And.Left",
+				Slot: -1,
+				Type: "*stmt.ExprMock",
+				Err:  cause,
+			},
+		},
+		{
+			name: "BuildError",
+			op:   "And.Left",
+			node: &Condition{},
+			err: &BuildError{
+				Op:   "Condition(name)",
+				Slot: -1,
+				Type: "*stmt.CompIn",
+				Err:  ErrEmptyIn,
+			},
+			want: &BuildError{
+				Op:   "And.Left.Condition(name)",
+				Slot: -1,
+				Type: "*stmt.CompIn",
+				Err:  ErrEmptyIn,
+			},
+		},
+		{
+			name: "BuildError without op",
+			op:   "Condition(name)",
+			node: &CompIn{},
+			err: &BuildError{
+				Slot: -1,
+				Type: "*stmt.CompIn",
+				Err:  ErrEmptyIn,
+			},
+			want: &BuildError{
+				Op:   "Condition(name)",
+				Slot: -1,
+				Type: "*stmt.CompIn",
+				Err:  ErrEmptyIn,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := WrapError(tt.op, tt.node, tt.err)
+			if !reflect.DeepEqual(tt.want, got) {
+				t.Errorf("WrapError() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package stmt
+
+import "strings"
+
+var (
+	_ Expr = (*Func)(nil)
+	_ Expr = (*Cast)(nil)
+	_ Expr = (*Arith)(nil)
+)
+
+// Func represents a function call such as "LOWER(email)".
+//
+// Each of Args is written as the expression if it implements Expr such as
+// Column, otherwise it is bound as an argument.
+//
+// i.e. &Func{Name: "LOWER", Args: []interface{}{Column("email")}}
+type Func struct {
+	Name string
+	Args []interface{}
+}
+
+// Write writes the function call.
+func (f *Func) Write(b Builder) error {
+	if f.Name == "" {
+		return newError("Func.Name", f, ErrEmptyString)
+	}
+	b.WriteString(f.Name)
+	b.WriteString("(")
+	for i, arg := range f.Args {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if err := writeOperand(b, "Func.Args", arg); err != nil {
+			return err
+		}
+	}
+	b.WriteString(")")
+	return nil
+}
+
+// Cast represents a type conversion such as "CAST(created_at AS DATE)".
+//
+// Value is written as the expression if it implements Expr, otherwise it is
+// bound as an argument. Type is the name of the type of the dialect.
+// PostgreSQL uses the shorthand "value::type".
+type Cast struct {
+	Value interface{}
+	Type  string
+}
+
+// Write writes the type conversion.
+func (c *Cast) Write(b Builder) error {
+	if c.Value == nil {
+		return newError("Cast.Value", c, ErrNilOperand)
+	}
+	if c.Type == "" {
+		return newError("Cast.Type", c, ErrEmptyString)
+	}
+	if DialectOf(b).Name() == "postgres" {
+		b.WriteString("(")
+		if err := writeOperand(b, "Cast.Value", c.Value); err != nil {
+			return err
+		}
+		b.WriteString(")::")
+		b.WriteString(c.Type)
+		return nil
+	}
+	b.WriteString("CAST(")
+	if err := writeOperand(b, "Cast.Value", c.Value); err != nil {
+		return err
+	}
+	b.WriteString(" AS ")
+	b.WriteString(c.Type)
+	b.WriteString(")")
+	return nil
+}
+
+// Arith represents an arithmetic expression such as "(cost * ?)".
+//
+// Op field should contain "+", "-", "*", "/" or "%". Left and Right are
+// written as the expressions if they implement Expr, otherwise they are
+// bound as arguments. The expression is written with parentheses so that
+// it is not affected by the precedence of the operators around it.
+// "%" is written as "MOD(left, right)" on Oracle.
+type Arith struct {
+	Op    string
+	Left  interface{}
+	Right interface{}
+}
+
+// Write writes the arithmetic expression.
+func (a *Arith) Write(b Builder) error {
+	if a.Left == nil {
+		return newError("Arith.Left", a, ErrNilOperand)
+	}
+	if a.Right == nil {
+		return newError("Arith.Right", a, ErrNilOperand)
+	}
+	switch a.Op {
+	case "+", "-", "*", "/", "%":
+	default:
+		return newError("Arith.Op", a, ErrInvalidOperator)
+	}
+	if a.Op == "%" && DialectOf(b).Name() == "oracle" {
+		return (&Func{Name: "MOD", Args: []interface{}{a.Left, a.Right}}).Write(b)
+	}
+	b.WriteString("(")
+	if err := writeOperand(b, "Arith.Left", a.Left); err != nil {
+		return err
+	}
+	b.WriteString(" ")
+	b.WriteString(a.Op)
+	b.WriteString(" ")
+	if err := writeOperand(b, "Arith.Right", a.Right); err != nil {
+		return err
+	}
+	b.WriteString(")")
+	return nil
+}
+
+// exprString returns the expression as a string which is used in the path
+// of BuildError. Placeholders are written as '?'.
+func exprString(e Expr) string {
+	var b textBuilder
+	if err := e.Write(&b); err != nil {
+		return typeName(e)
+	}
+	return b.String()
+}
+
+var _ Builder = (*textBuilder)(nil)
+
+// textBuilder is a Builder which writes only the text of the query.
+type textBuilder struct {
+	strings.Builder
+}
+
+func (b *textBuilder) WritePlaceholder()         { b.Builder.WriteString("?") }
+func (b *textBuilder) WriteString(s string)      { b.Builder.WriteString(s) }
+func (b *textBuilder) AppendArgs(...interface{}) {}
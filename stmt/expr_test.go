@@ -0,0 +1,167 @@
+package stmt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExpr_Write(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		expr     Expr
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:    "func",
+			dialect: dialect.MySQL{},
+			expr:    &Func{Name: "LOWER", Args: []interface{}{Column("email")}},
+			want:    "LOWER(email)",
+		},
+		{
+			name:     "func with args",
+			dialect:  dialect.PostgreSQL{},
+			expr:     &Func{Name: "COALESCE", Args: []interface{}{Column("nickname"), "anonymous"}},
+			want:     "COALESCE(nickname, $1)",
+			wantArgs: []interface{}{"anonymous"},
+		},
+		{
+			name:    "func without args",
+			dialect: dialect.MySQL{},
+			expr:    &Func{Name: "NOW"},
+			want:    "NOW()",
+		},
+		{
+			name:    "cast",
+			dialect: dialect.MySQL{},
+			expr:    &Cast{Value: Column("created_at"), Type: "DATE"},
+			want:    "CAST(created_at AS DATE)",
+		},
+		{
+			name:    "cast postgres",
+			dialect: dialect.PostgreSQL{},
+			expr:    &Cast{Value: Column("created_at"), Type: "date"},
+			want:    "(created_at)::date",
+		},
+		{
+			name:     "cast arg",
+			dialect:  dialect.SQLServer{},
+			expr:     &Cast{Value: "1", Type: "INT"},
+			want:     "CAST(@p1 AS INT)",
+			wantArgs: []interface{}{"1"},
+		},
+		{
+			name:     "arith",
+			dialect:  dialect.PostgreSQL{},
+			expr:     &Arith{Op: "*", Left: Column("price"), Right: 2},
+			want:     "(price * $1)",
+			wantArgs: []interface{}{2},
+		},
+		{
+			name:    "arith nested",
+			dialect: dialect.MySQL{},
+			expr: &Arith{
+				Op:    "-",
+				Left:  &Arith{Op: "+", Left: Column("a"), Right: Column("b")},
+				Right: Column("c"),
+			},
+			want: "((a + b) - c)",
+		},
+		{
+			name:     "arith mod",
+			dialect:  dialect.MySQL{},
+			expr:     &Arith{Op: "%", Left: Column("id"), Right: 10},
+			want:     "(id % ?)",
+			wantArgs: []interface{}{10},
+		},
+		{
+			name:     "arith mod oracle",
+			dialect:  dialect.Oracle{},
+			expr:     &Arith{Op: "%", Left: Column("id"), Right: 10},
+			want:     "MOD(id, :1)",
+			wantArgs: []interface{}{10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			if err := tt.expr.Write(b); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Write() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExpr_Write_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    Expr
+		wantOp  string
+		wantErr error
+	}{
+		{
+			name:    "func name",
+			expr:    &Func{},
+			wantOp:  "Func.Name",
+			wantErr: ErrEmptyString,
+		},
+		{
+			name:    "func args",
+			expr:    &Func{Name: "LOWER", Args: []interface{}{Column("")}},
+			wantOp:  "Func.Args",
+			wantErr: ErrEmptyString,
+		},
+		{
+			name:    "cast value",
+			expr:    &Cast{Type: "DATE"},
+			wantOp:  "Cast.Value",
+			wantErr: ErrNilOperand,
+		},
+		{
+			name:    "cast type",
+			expr:    &Cast{Value: Column("a")},
+			wantOp:  "Cast.Type",
+			wantErr: ErrEmptyString,
+		},
+		{
+			name:    "arith left",
+			expr:    &Arith{Op: "+", Right: 1},
+			wantOp:  "Arith.Left",
+			wantErr: ErrNilOperand,
+		},
+		{
+			name:    "arith right",
+			expr:    &Arith{Op: "+", Left: 1},
+			wantOp:  "Arith.Right",
+			wantErr: ErrNilOperand,
+		},
+		{
+			name:    "arith op",
+			expr:    &Arith{Op: "; DROP", Left: 1, Right: 2},
+			wantOp:  "Arith.Op",
+			wantErr: ErrInvalidOperator,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.expr.Write(new(BuildCapture))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Write() error = %v, want %v", err, tt.wantErr)
+			}
+			var e *BuildError
+			if !errors.As(err, &e) || e.Op != tt.wantOp {
+				t.Errorf("Write() error = %v, want op %q", err, tt.wantOp)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package stmt
+
+// InOptions represents the options of CompIn which are applied to
+// the whole query. The Builder which is provided by sqb implements
+// InBuilder to pass them.
+type InOptions struct {
+	// Bucket pads the list of values to the size which is returned by it.
+	// If it is nil, the list is not padded. See also CompIn.Bucket.
+	Bucket BucketFunc
+	// ArrayThreshold writes the list which has at least ArrayThreshold
+	// values as a single array parameter if the dialect supports it,
+	// such as "= ANY($1)". If it is zero, the array parameter is not used
+	// unless CompIn.Array is enabled. See also dialect.ArrayParam.
+	ArrayThreshold int
+	// EmptyIn decides how the IN which has no values is written.
+	// See also EmptyInPolicy.
+	EmptyIn EmptyInPolicy
+}
+
+// EmptyInPolicy represents how the IN which has no values is written.
+type EmptyInPolicy int
+
+const (
+	// EmptyInError returns ErrEmptyIn. It is the default policy.
+	EmptyInError EmptyInPolicy = iota
+	// EmptyInPredicate writes the IN which has no values as the predicate
+	// which is always false, such as "1=0", and the NOT IN as the predicate
+	// which is always true, such as "1=1". So that the empty selection
+	// returns no rows. See also dialect.Predicate.
+	EmptyInPredicate
+)
+
+// InBuilder is the interface that wraps Builder and InOptions method.
+//
+// InOptions method returns the options of CompIn which are applied to
+// the whole query.
+type InBuilder interface {
+	Builder
+	InOptions() InOptions
+}
+
+// InOptionsOf returns the options of CompIn of the Builder. If b does not
+// implement InBuilder, it returns zero value.
+func InOptionsOf(b Builder) InOptions {
+	if ib, ok := b.(InBuilder); ok {
+		return ib.InOptions()
+	}
+	return InOptions{}
+}
+
+// BucketFunc returns the padded size of the list which has n values.
+// If it returns less than n, the list is not padded.
+//
+// Padding the list limits the number of distinct queries, so that
+// the prepared statement cache and the plan cache of the database
+// are not thrashed by the various length of the list.
+type BucketFunc func(n int) int
+
+// PowerOfTwo is a BucketFunc which returns the smallest power of two
+// which is greater than or equal to n. e.g. 1, 2, 4, 8, 16...
+func PowerOfTwo(n int) int {
+	size := 1
+	for size < n {
+		size <<= 1
+	}
+	return size
+}
+
+// Buckets returns a BucketFunc which returns the smallest size of sizes
+// which is greater than or equal to n. sizes should be sorted in ascending
+// order. If n exceeds all sizes, the list is not padded.
+func Buckets(sizes ...int) BucketFunc {
+	return func(n int) int {
+		for _, size := range sizes {
+			if size >= n {
+				return size
+			}
+		}
+		return n
+	}
+}
+
+// pad pads args up to the size of bucket by repeating the last value.
+// The last value is used instead of NULL because "NOT IN (..., NULL)"
+// is never true.
+func pad(args []interface{}, bucket BucketFunc) []interface{} {
+	if bucket == nil || len(args) == 0 {
+		return args
+	}
+	size := bucket(len(args))
+	if size <= len(args) {
+		return args
+	}
+	padded := make([]interface{}, size)
+	copy(padded, args)
+	last := args[len(args)-1]
+	for i := len(args); i < size; i++ {
+		padded[i] = last
+	}
+	return padded
+}
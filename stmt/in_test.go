@@ -0,0 +1,48 @@
+package stmt
+
+import "testing"
+
+func TestPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{n: 0, want: 1},
+		{n: 1, want: 1},
+		{n: 2, want: 2},
+		{n: 3, want: 4},
+		{n: 5, want: 8},
+		{n: 16, want: 16},
+		{n: 17, want: 32},
+	}
+	for _, tt := range tests {
+		if got := PowerOfTwo(tt.n); got != tt.want {
+			t.Errorf("PowerOfTwo(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestBuckets(t *testing.T) {
+	bucket := Buckets(10, 100, 1000)
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{n: 1, want: 10},
+		{n: 10, want: 10},
+		{n: 11, want: 100},
+		{n: 999, want: 1000},
+		{n: 1001, want: 1001},
+	}
+	for _, tt := range tests {
+		if got := bucket(tt.n); got != tt.want {
+			t.Errorf("Buckets()(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestInOptionsOf(t *testing.T) {
+	if got := InOptionsOf(&BuildCapture{}); got.Bucket != nil {
+		t.Errorf("InOptionsOf() = %v, want zero value", got)
+	}
+}
@@ -0,0 +1,83 @@
+package stmt
+
+// negatedOps maps the operator of CompOp to the negated one.
+var negatedOps = map[string]string{
+	"=":      "!=",
+	"!=":     "=",
+	"<>":     "=",
+	">":      "<=",
+	">=":     "<",
+	"<":      ">=",
+	"<=":     ">",
+	"IS":     "IS NOT",
+	"IS NOT": "IS",
+}
+
+// Negate returns the negated expression of expr. The negation is pushed
+// down to the conditions so that the query is able to use the indexes,
+// instead of wrapping the whole expression with "NOT (...)".
+//
+// The Negative field of CompIn, CompLike, CompBetween, CompNull and
+// CompDistinct is flipped, the operator of CompOp is inverted such as
+// "=" to "!=" and ">" to "<=", And and Or are swapped by De Morgan's laws
+// and Not is removed. The other expressions are wrapped with Not.
+//
+// expr is not modified. The nodes on the path to the conditions are copied.
+func Negate(expr Expr) Expr {
+	switch e := expr.(type) {
+	case *Not:
+		return e.Expr
+	case *And:
+		return &Or{Left: Negate(e.Left), Right: Negate(e.Right)}
+	case *Or:
+		return &And{Left: Negate(e.Left), Right: Negate(e.Right)}
+	case *Paren:
+		if e.Expr == nil {
+			break
+		}
+		return &Paren{Expr: Negate(e.Expr)}
+	case *Condition:
+		if c, ok := negateComparison(e.Compare); ok {
+			ret := *e
+			ret.Compare = c
+			return &ret
+		}
+	}
+	return &Not{Expr: expr}
+}
+
+// negateComparison returns the negated comparison of c. It reports false
+// if c cannot be negated.
+func negateComparison(c Comparisoner) (Comparisoner, bool) {
+	switch c := c.(type) {
+	case *CompOp:
+		op, ok := negatedOps[c.Op]
+		if !ok {
+			return nil, false
+		}
+		ret := *c
+		ret.Op = op
+		return &ret, true
+	case *CompIn:
+		ret := *c
+		ret.Negative = !c.Negative
+		return &ret, true
+	case *CompLike:
+		ret := *c
+		ret.Negative = !c.Negative
+		return &ret, true
+	case *CompBetween:
+		ret := *c
+		ret.Negative = !c.Negative
+		return &ret, true
+	case *CompNull:
+		ret := *c
+		ret.Negative = !c.Negative
+		return &ret, true
+	case *CompDistinct:
+		ret := *c
+		ret.Negative = !c.Negative
+		return &ret, true
+	}
+	return nil, false
+}
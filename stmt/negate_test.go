@@ -0,0 +1,102 @@
+package stmt
+
+import (
+	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNegate(t *testing.T) {
+	cond := func(column string, c Comparisoner) *Condition {
+		return &Condition{Column: column, Compare: c}
+	}
+	tests := []struct {
+		name     string
+		expr     Expr
+		want     string
+		wantArgs []interface{}
+	}{
+		{name: "eq", expr: cond("a", &CompOp{Op: "=", Value: 1}), want: "a != ?", wantArgs: []interface{}{1}},
+		{name: "ne", expr: cond("a", &CompOp{Op: "<>", Value: 1}), want: "a = ?", wantArgs: []interface{}{1}},
+		{name: "gt", expr: cond("a", &CompOp{Op: ">", Value: 1}), want: "a <= ?", wantArgs: []interface{}{1}},
+		{name: "ge", expr: cond("a", &CompOp{Op: ">=", Value: 1}), want: "a < ?", wantArgs: []interface{}{1}},
+		{name: "lt", expr: cond("a", &CompOp{Op: "<", Value: 1}), want: "a >= ?", wantArgs: []interface{}{1}},
+		{name: "le", expr: cond("a", &CompOp{Op: "<=", Value: 1}), want: "a > ?", wantArgs: []interface{}{1}},
+		{name: "is", expr: cond("a", &CompOp{Op: "IS", Value: nil}), want: "a IS NOT NULL"},
+		{name: "unknown op", expr: cond("a", &CompOp{Op: "@>", Value: 1}), want: "NOT (a @> ?)", wantArgs: []interface{}{1}},
+		{name: "in", expr: cond("a", &CompIn{Values: []interface{}{1, 2}}), want: "a NOT IN (?, ?)", wantArgs: []interface{}{1, 2}},
+		{name: "not in", expr: cond("a", &CompIn{Negative: true, Values: []interface{}{1}}), want: "a IN (?)", wantArgs: []interface{}{1}},
+		{name: "like", expr: cond("a", &CompLike{Value: "%x"}), want: "a NOT LIKE ?", wantArgs: []interface{}{"%x"}},
+		{name: "between", expr: cond("a", &CompBetween{Left: 1, Right: 2}), want: "a NOT BETWEEN ? AND ?", wantArgs: []interface{}{1, 2}},
+		{name: "null", expr: cond("a", &CompNull{}), want: "a IS NOT NULL"},
+		{name: "distinct", expr: cond("a", &CompDistinct{Value: 1}), want: "a <=> ?", wantArgs: []interface{}{1}},
+		{
+			name: "and",
+			expr: &And{
+				Left:  cond("a", &CompIn{Values: []interface{}{1, 2}}),
+				Right: cond("b", &CompOp{Op: ">=", Value: 3}),
+			},
+			want:     "(a NOT IN (?, ?) OR b < ?)",
+			wantArgs: []interface{}{1, 2, 3},
+		},
+		{
+			name: "or",
+			expr: &Or{
+				Left:  cond("a", &CompLike{Value: "x%"}),
+				Right: cond("b", &CompNull{Negative: true}),
+			},
+			want:     "a NOT LIKE ? AND b IS NULL",
+			wantArgs: []interface{}{"x%"},
+		},
+		{
+			name: "nested",
+			expr: &And{
+				Left: cond("a", &CompOp{Op: "=", Value: 1}),
+				Right: &Paren{Expr: &Or{
+					Left:  cond("b", &CompOp{Op: "=", Value: 2}),
+					Right: cond("c", &CompOp{Op: "=", Value: 3}),
+				}},
+			},
+			want:     "(a != ? OR (b != ? AND c != ?))",
+			wantArgs: []interface{}{1, 2, 3},
+		},
+		{
+			name:     "not",
+			expr:     &Not{Expr: cond("a", &CompOp{Op: "=", Value: 1})},
+			want:     "a = ?",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name: "other",
+			expr: &ExprMock{WriteMock: func(b Builder) error {
+				b.WriteString("f(x)")
+				return nil
+			}},
+			want: "NOT (f(x))",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: dialect.MySQL{}}
+			if err := Negate(tt.expr).Write(b); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Negate() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNegate_NotModified(t *testing.T) {
+	in := &CompIn{Values: []interface{}{1}}
+	c := &Condition{Column: "a", Compare: in}
+	Negate(&And{Left: c, Right: c})
+	if in.Negative {
+		t.Errorf("Negate should not modify the original expression")
+	}
+}
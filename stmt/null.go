@@ -0,0 +1,128 @@
+package stmt
+
+import (
+	"database/sql/driver"
+	"reflect"
+
+	"github.com/Code-Hex/sqb/internal/slice"
+)
+
+var _ Comparisoner = (*CompNull)(nil)
+
+// CompNull represents condition for using "IS NULL".
+//
+// If enabled Negative field, it's meaning use "IS NOT NULL".
+type CompNull struct {
+	Negative bool
+}
+
+// WriteComparison implemented Comparisoner interface.
+func (c *CompNull) WriteComparison(b Builder) error {
+	if c.Negative {
+		b.WriteString("IS NOT NULL")
+	} else {
+		b.WriteString("IS NULL")
+	}
+	return nil
+}
+
+// NullBuilder is the interface that wraps Builder and NilAsNull method.
+//
+// NilAsNull method reports whether nil values are compared as NULL.
+// If it returns true, "= nil" is written as "IS NULL", "!= nil" is written
+// as "IS NOT NULL", and nil in the list of IN is written as
+// "(column IN (...) OR column IS NULL)".
+type NullBuilder interface {
+	Builder
+	NilAsNull() bool
+}
+
+// IsNilAsNull reports whether b compares nil values as NULL.
+// See also NullBuilder.
+func IsNilAsNull(b Builder) bool {
+	nb, ok := b.(NullBuilder)
+	return ok && nb.NilAsNull()
+}
+
+// isNil reports whether v is written as NULL by the database driver.
+func isNil(v interface{}) bool {
+	if v == nil {
+		return true
+	}
+	if _, ok := v.(driver.Valuer); ok {
+		return false
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// splitNull returns the copy of c which the values compared as NULL are
+// removed from. It reports whether the values contain such values.
+// See also isNullValue.
+func (c *CompIn) splitNull(b Builder) (*CompIn, bool) {
+	values := slice.Flatten(c.Values)
+	var ret []interface{}
+	for i, v := range values {
+		if !isNullValue(b, v) {
+			if ret != nil {
+				ret = append(ret, v)
+			}
+			continue
+		}
+		if ret == nil {
+			ret = make([]interface{}, i, len(values))
+			copy(ret, values)
+		}
+	}
+	if ret == nil {
+		return c, false
+	}
+	in := *c
+	in.Values = ret
+	return &in, true
+}
+
+// isNullValue reports whether v in the list of IN is compared as NULL.
+// nil is compared as NULL if the builder compares nil values as NULL, and
+// an empty string is compared as NULL if the dialect treats it as NULL.
+func isNullValue(b Builder, v interface{}) bool {
+	if isEmptyStringNull(b, v) {
+		return true
+	}
+	return IsNilAsNull(b) && isNil(v)
+}
+
+// writeNullIn writes the condition of IN whose values contain nil.
+// "column IN (1, nil)" is written as "(column IN (1) OR column IS NULL)"
+// and "column NOT IN (1, nil)" is written as
+// "(column NOT IN (1) AND column IS NOT NULL)".
+func writeNullIn(b Builder, left Expr, in *CompIn) error {
+	null := &CompNull{Negative: in.Negative}
+	if len(in.Values) == 0 {
+		if err := writeLeft(b, left); err != nil {
+			return err
+		}
+		b.WriteString(" ")
+		return null.WriteComparison(b)
+	}
+	b.WriteString("(")
+	if err := writeLeft(b, left); err != nil {
+		return err
+	}
+	b.WriteString(" ")
+	if err := in.WriteComparison(b); err != nil {
+		return err
+	}
+	if in.Negative {
+		b.WriteString(" AND ")
+	} else {
+		b.WriteString(" OR ")
+	}
+	if err := writeLeft(b, left); err != nil {
+		return err
+	}
+	b.WriteString(" ")
+	null.WriteComparison(b)
+	b.WriteString(")")
+	return nil
+}
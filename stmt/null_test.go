@@ -0,0 +1,33 @@
+package stmt
+
+import "testing"
+
+func TestCompNull_WriteComparison(t *testing.T) {
+	tests := []struct {
+		c    *CompNull
+		want string
+	}{
+		{c: &CompNull{}, want: "IS NULL"},
+		{c: &CompNull{Negative: true}, want: "IS NOT NULL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			b := new(BuildCapture)
+			if err := tt.c.WriteComparison(b); err != nil {
+				t.Fatalf("CompNull.WriteComparison() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("CompNull.WriteComparison() = %q, want %q", got, tt.want)
+			}
+			if len(b.Args) != 0 {
+				t.Errorf("args should be empty: %v", b.Args)
+			}
+		})
+	}
+}
+
+func TestIsNilAsNull(t *testing.T) {
+	if IsNilAsNull(new(BuildCapture)) {
+		t.Errorf("IsNilAsNull() should be false if the builder does not implement NullBuilder")
+	}
+}
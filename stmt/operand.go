@@ -0,0 +1,55 @@
+package stmt
+
+var (
+	_ Expr = Column("")
+	_ Expr = (*Arg)(nil)
+)
+
+// Column represents a column reference which is used as an operand of
+// the comparison, such as "a.updated_at > b.synced_at". It is written
+// as it is like Condition.Column.
+//
+// i.e. Gt("a.updated_at", Column("b.synced_at"))
+type Column string
+
+// Write writes the column name.
+func (c Column) Write(b Builder) error {
+	if c == "" {
+		return newError("", c, ErrEmptyString)
+	}
+	b.WriteString(string(c))
+	return nil
+}
+
+// Arg represents a bound argument which is used as an operand of
+// the comparison. It is written as a placeholder.
+//
+// The value which is not an Expr is bound as an argument without Arg.
+// It is useful to bind the value which implements Expr as an argument.
+type Arg struct {
+	Value interface{}
+}
+
+// Write writes the placeholder and appends the value to the args.
+func (a *Arg) Write(b Builder) error {
+	b.WritePlaceholder()
+	b.AppendArgs(a.Value)
+	return nil
+}
+
+// writeOperand writes the operand of the comparison.
+//
+// If v implements Expr such as Column, String and Arg, it is written as
+// the expression. Otherwise v is bound as an argument.
+func writeOperand(b Builder, op string, v interface{}) error {
+	e, ok := v.(Expr)
+	if !ok {
+		b.WritePlaceholder()
+		b.AppendArgs(v)
+		return nil
+	}
+	if err := e.Write(b); err != nil {
+		return WrapError(op, e, err)
+	}
+	return nil
+}
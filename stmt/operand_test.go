@@ -0,0 +1,96 @@
+package stmt
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestOperand(t *testing.T) {
+	tests := []struct {
+		name     string
+		c        Comparisoner
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "value",
+			c:        &CompOp{Op: ">", Value: 1},
+			want:     "> $1",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name: "column",
+			c:    &CompOp{Op: ">", Value: Column("b.synced_at")},
+			want: "> b.synced_at",
+		},
+		{
+			name: "raw",
+			c:    &CompOp{Op: "<", Value: String("NOW()")},
+			want: "< NOW()",
+		},
+		{
+			name: "expression",
+			c: &CompOp{Op: "=", Value: &Paren{
+				Expr: &Condition{Column: "x", Compare: &CompOp{Op: "=", Value: 1}},
+			}},
+			want:     "= (x = $1)",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name:     "arg",
+			c:        &CompOp{Op: "=", Value: &Arg{Value: String("s")}},
+			want:     "= $1",
+			wantArgs: []interface{}{String("s")},
+		},
+		{
+			name: "like",
+			c:    &CompLike{Negative: true, Value: Column("pattern")},
+			want: "NOT LIKE pattern",
+		},
+		{
+			name:     "between",
+			c:        &CompBetween{Left: Column("a.start"), Right: 10},
+			want:     "BETWEEN a.start AND $1",
+			wantArgs: []interface{}{10},
+		},
+		{
+			name: "distinct",
+			c:    &CompDistinct{Value: Column("b.name")},
+			want: "IS DISTINCT FROM b.name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: dialect.PostgreSQL{}}
+			if err := tt.c.WriteComparison(b); err != nil {
+				t.Fatalf("WriteComparison() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("WriteComparison() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOperand_Error(t *testing.T) {
+	c := &CompBetween{Left: 1, Right: Column("")}
+	err := c.WriteComparison(new(BuildCapture))
+	if !errors.Is(err, ErrEmptyString) {
+		t.Fatalf("CompBetween.WriteComparison() error = %v, want %v", err, ErrEmptyString)
+	}
+	var e *BuildError
+	if !errors.As(err, &e) || e.Op != "CompBetween.Right" {
+		t.Errorf("CompBetween.WriteComparison() error = %v, want op %q", err, "CompBetween.Right")
+	}
+
+	err = Validate(&Condition{Column: "a", Compare: &CompOp{Op: "=", Value: Column("")}})
+	if !errors.Is(err, ErrEmptyString) {
+		t.Errorf("Validate() error = %v, want %v", err, ErrEmptyString)
+	}
+}
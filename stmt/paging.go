@@ -0,0 +1,43 @@
+package stmt
+
+// Paging represents which of Limit and Offset have been written while
+// building the query. See also PagingBuilder.
+type Paging int
+
+const (
+	// PagingLimit represents Limit has been written.
+	PagingLimit Paging = 1 << iota
+	// PagingOffset represents Offset has been written.
+	PagingOffset
+)
+
+// PagingBuilder is the interface that wraps Builder, Paging and SetPaging
+// method.
+//
+// Paging method returns which of Limit and Offset have been written while
+// building the query, and SetPaging method records it. If the dialect
+// writes the limit and the offset as a single clause such as SQL Server,
+// Limit which follows Offset is written as "FETCH NEXT <limit_num> ROWS ONLY"
+// so that they make "OFFSET ... FETCH ...". The Builder which is provided
+// by sqb implements this interface.
+type PagingBuilder interface {
+	Builder
+	Paging() Paging
+	SetPaging(Paging)
+}
+
+// pagingOf returns which of Limit and Offset have been written into b.
+// It returns zero if b does not implement PagingBuilder.
+func pagingOf(b Builder) Paging {
+	if pb, ok := b.(PagingBuilder); ok {
+		return pb.Paging()
+	}
+	return 0
+}
+
+// setPaging records p into b if b implements PagingBuilder.
+func setPaging(b Builder, p Paging) {
+	if pb, ok := b.(PagingBuilder); ok {
+		pb.SetPaging(p)
+	}
+}
@@ -0,0 +1,123 @@
+package stmt
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+
+	"github.com/Code-Hex/sqb/internal/lexer"
+)
+
+var _ Expr = (*Raw)(nil)
+
+// Raw represents a hand-written SQL fragment which has its own arguments,
+// such as "ST_DWithin(geom, ?, ?)".
+//
+// Each '?' marker in SQL is written as the placeholder of the dialect and
+// bound to Args in order, so that "$1" or "@p1" are numbered through the
+// whole query. "??" is written as '?' such as the jsonb operator of
+// PostgreSQL. The markers which are placed in string literals, quoted
+// identifiers or comments are written as they are.
+//
+// If Args is a single map[string]interface{} or consists of sql.NamedArg,
+// the named markers such as ":name" are bound to the values by the name.
+//
+// i.e. &Raw{SQL: "a = :x", Args: []interface{}{map[string]interface{}{"x": 1}}}
+type Raw struct {
+	SQL  string
+	Args []interface{}
+}
+
+// Write writes the fragment and appends the arguments.
+func (r *Raw) Write(b Builder) error {
+	named, ok := r.named()
+	args := r.Args
+	if ok {
+		args = nil
+	}
+	used := make([]bool, len(named))
+
+	s := lexer.New(r.SQL, "?", lexer.RulesOf(DialectOf(b).Name()))
+	if ok {
+		s.SetParamPrefix(':')
+	}
+	var n int
+	for s.Scan() {
+		tok := s.Token()
+		switch tok.Kind {
+		case lexer.Text, lexer.NamedSlot:
+			b.WriteString(tok.Value)
+		case lexer.Placeholder:
+			b.WriteString("?")
+		case lexer.Slot:
+			if n >= len(args) {
+				return newError("Raw.Args", r, ErrMissingRawArg)
+			}
+			b.WritePlaceholder()
+			b.AppendArgs(args[n])
+			n++
+		case lexer.NamedParam:
+			i := lookupNamedArg(named, tok.Name)
+			if i == -1 {
+				err := fmt.Errorf("%w: %s", ErrMissingRawArg, tok.Value)
+				return newError("Raw.Args", r, err)
+			}
+			b.WritePlaceholder()
+			b.AppendArgs(named[i].Value)
+			used[i] = true
+		}
+	}
+	if err := s.Err(); err != nil {
+		return newError("Raw.SQL", r, err)
+	}
+	if n < len(args) {
+		return newError("Raw.Args", r, ErrUnusedRawArg)
+	}
+	for i, ok := range used {
+		if !ok {
+			err := fmt.Errorf("%w: :%s", ErrUnusedRawArg, named[i].Name)
+			return newError("Raw.Args", r, err)
+		}
+	}
+	return nil
+}
+
+// named returns the named arguments. It reports false if Args is not
+// a single map[string]interface{} or does not consist of sql.NamedArg.
+func (r *Raw) named() ([]sql.NamedArg, bool) {
+	if len(r.Args) == 1 {
+		if m, ok := r.Args[0].(map[string]interface{}); ok {
+			named := make([]sql.NamedArg, 0, len(m))
+			for name, v := range m {
+				named = append(named, sql.Named(name, v))
+			}
+			sort.Slice(named, func(i, j int) bool {
+				return named[i].Name < named[j].Name
+			})
+			return named, true
+		}
+	}
+	if len(r.Args) == 0 {
+		return nil, false
+	}
+	named := make([]sql.NamedArg, len(r.Args))
+	for i, arg := range r.Args {
+		na, ok := arg.(sql.NamedArg)
+		if !ok {
+			return nil, false
+		}
+		named[i] = na
+	}
+	return named, true
+}
+
+// lookupNamedArg returns the index of the argument which is named name.
+// It returns -1 if not found.
+func lookupNamedArg(named []sql.NamedArg, name string) int {
+	for i, na := range named {
+		if na.Name == name {
+			return i
+		}
+	}
+	return -1
+}
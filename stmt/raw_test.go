@@ -0,0 +1,149 @@
+package stmt
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
+	"github.com/Code-Hex/sqb/internal/lexer"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRaw_Write(t *testing.T) {
+	tests := []struct {
+		name     string
+		dialect  dialect.Dialect
+		raw      *Raw
+		want     string
+		wantArgs []interface{}
+	}{
+		{
+			name:     "mysql",
+			dialect:  dialect.MySQL{},
+			raw:      &Raw{SQL: "ST_DWithin(geom, ?, ?)", Args: []interface{}{"POINT(0 0)", 100}},
+			want:     "ST_DWithin(geom, ?, ?)",
+			wantArgs: []interface{}{"POINT(0 0)", 100},
+		},
+		{
+			name:     "postgres",
+			dialect:  dialect.PostgreSQL{},
+			raw:      &Raw{SQL: "x @> ?::jsonb", Args: []interface{}{`{"a":1}`}},
+			want:     "x @> $1::jsonb",
+			wantArgs: []interface{}{`{"a":1}`},
+		},
+		{
+			name:     "sqlserver",
+			dialect:  dialect.SQLServer{},
+			raw:      &Raw{SQL: "a = ? AND b = ?", Args: []interface{}{1, 2}},
+			want:     "a = @p1 AND b = @p2",
+			wantArgs: []interface{}{1, 2},
+		},
+		{
+			name:     "escaped marker",
+			dialect:  dialect.PostgreSQL{},
+			raw:      &Raw{SQL: "tags ?? ?", Args: []interface{}{"go"}},
+			want:     "tags ? $1",
+			wantArgs: []interface{}{"go"},
+		},
+		{
+			name:     "quoted marker",
+			dialect:  dialect.PostgreSQL{},
+			raw:      &Raw{SQL: "a = 'what?' AND b = ? /* ? */", Args: []interface{}{1}},
+			want:     "a = 'what?' AND b = $1 /* ? */",
+			wantArgs: []interface{}{1},
+		},
+		{
+			name:    "no args",
+			dialect: dialect.MySQL{},
+			raw:     &Raw{SQL: "deleted_at IS NULL"},
+			want:    "deleted_at IS NULL",
+		},
+		{
+			name:    "map",
+			dialect: dialect.PostgreSQL{},
+			raw: &Raw{
+				SQL:  "a = :x AND b = :y AND c = :x AND d::text = ':z'",
+				Args: []interface{}{map[string]interface{}{"x": 1, "y": 2}},
+			},
+			want:     "a = $1 AND b = $2 AND c = $3 AND d::text = ':z'",
+			wantArgs: []interface{}{1, 2, 1},
+		},
+		{
+			name:    "named args",
+			dialect: dialect.MySQL{},
+			raw: &Raw{
+				SQL:  "a BETWEEN :from AND :to",
+				Args: []interface{}{sql.Named("from", 1), sql.Named("to", 10)},
+			},
+			want:     "a BETWEEN ? AND ?",
+			wantArgs: []interface{}{1, 10},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			if err := tt.raw.Write(b); err != nil {
+				t.Fatalf("Raw.Write() error = %v", err)
+			}
+			if got := b.buf.String(); got != tt.want {
+				t.Errorf("Raw.Write() = %q, want %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, b.Args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRaw_Write_Error(t *testing.T) {
+	tests := []struct {
+		name    string
+		raw     *Raw
+		wantOp  string
+		wantErr error
+	}{
+		{
+			name:    "missing arg",
+			raw:     &Raw{SQL: "a = ? AND b = ?", Args: []interface{}{1}},
+			wantOp:  "Raw.Args",
+			wantErr: ErrMissingRawArg,
+		},
+		{
+			name:    "unused arg",
+			raw:     &Raw{SQL: "a = ?", Args: []interface{}{1, 2}},
+			wantOp:  "Raw.Args",
+			wantErr: ErrUnusedRawArg,
+		},
+		{
+			name:    "missing name",
+			raw:     &Raw{SQL: "a = :x AND b = :y", Args: []interface{}{map[string]interface{}{"x": 1}}},
+			wantOp:  "Raw.Args",
+			wantErr: ErrMissingRawArg,
+		},
+		{
+			name:    "unused name",
+			raw:     &Raw{SQL: "a = :x", Args: []interface{}{sql.Named("x", 1), sql.Named("y", 2)}},
+			wantOp:  "Raw.Args",
+			wantErr: ErrUnusedRawArg,
+		},
+		{
+			name:    "unterminated",
+			raw:     &Raw{SQL: "a = 'b"},
+			wantOp:  "Raw.SQL",
+			wantErr: lexer.ErrUnterminated,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.raw.Write(new(BuildCapture))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Raw.Write() error = %v, want %v", err, tt.wantErr)
+			}
+			var e *BuildError
+			if !errors.As(err, &e) || e.Op != tt.wantOp {
+				t.Errorf("Raw.Write() error = %v, want op %q", err, tt.wantOp)
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package stmt
 
+import "github.com/Code-Hex/sqb/dialect"
+
 // Builder an interface used to build SQL queries.
 //
 // WriteString method uses the passed string is writing to the query builder.
@@ -10,6 +12,42 @@ type Builder interface {
 	AppendArgs(args ...interface{})
 }
 
+// DialectBuilder is the interface that wraps Builder and Dialect method.
+//
+// Dialect method returns the SQL dialect which is used to write the query.
+// The Builder which is provided by sqb implements this interface. Custom
+// Expr can use DialectOf to write database specific syntax.
+type DialectBuilder interface {
+	Builder
+	Dialect() dialect.Dialect
+}
+
+// DialectOf returns the dialect of the Builder. If b does not implement
+// DialectBuilder, it returns dialect.MySQL because it uses '?' as a placeholder.
+func DialectOf(b Builder) dialect.Dialect {
+	if db, ok := b.(DialectBuilder); ok {
+		return db.Dialect()
+	}
+	return dialect.MySQL{}
+}
+
+// ShapeBuilder is the interface that wraps Builder and Shape method.
+//
+// Shape method reports whether the builder writes the shape of the query
+// instead of the query which is executed, such as sqb.Builder.Fingerprint.
+// The Expr which writes a variable number of placeholders should collapse
+// them so that the shape does not depend on the number of values.
+type ShapeBuilder interface {
+	Builder
+	Shape() bool
+}
+
+// IsShape reports whether b writes the shape of the query. See also ShapeBuilder.
+func IsShape(b Builder) bool {
+	sb, ok := b.(ShapeBuilder)
+	return ok && sb.Shape()
+}
+
 // Expr implemented Write method.
 //
 // This interface represents an expression.
@@ -0,0 +1,33 @@
+package stmt
+
+import (
+	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
+)
+
+func TestDialectOf(t *testing.T) {
+	tests := []struct {
+		name string
+		b    Builder
+		want dialect.Dialect
+	}{
+		{
+			name: "not DialectBuilder",
+			b:    &BuildCapture{},
+			want: dialect.MySQL{},
+		},
+		{
+			name: "DialectBuilder",
+			b:    &DialectCapture{dialect: dialect.Spanner{}},
+			want: dialect.Spanner{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DialectOf(tt.b); got != tt.want {
+				t.Errorf("DialectOf() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
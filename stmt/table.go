@@ -1,8 +1,9 @@
 package stmt
 
 import (
-	"errors"
 	"strconv"
+
+	"github.com/Code-Hex/sqb/dialect"
 )
 
 // String is able to replace bindvars with string.
@@ -13,7 +14,7 @@ type String string
 // Write writes the string.
 func (s String) Write(b Builder) error {
 	if s == "" {
-		return errors.New("unspecified string")
+		return newError("", s, ErrEmptyString)
 	}
 	b.WriteString(string(s))
 	return nil
@@ -30,22 +31,90 @@ func (n Numeric) Write(b Builder) error {
 	return nil
 }
 
+// Ident is able to replace bindVars with the quoted identifier.
+// The identifier is quoted by the dialect of the Builder.
+//
+// i.e. "SELECT * FROM ?" => "SELECT * FROM `ident`"
+type Ident string
+
+// Write writes the quoted identifier.
+func (i Ident) Write(b Builder) error {
+	if i == "" {
+		return newError("", i, ErrEmptyString)
+	}
+	b.WriteString(DialectOf(b).QuoteIdent(string(i)))
+	return nil
+}
+
 // Limit represents "LIMIT <limit_num>".
+// The syntax is decided by the dialect of the Builder.
+//
+// If the dialect writes the limit and the offset as a single clause such
+// as SQL Server and Oracle, Limit which follows Offset in the same query is
+// written as "FETCH NEXT <limit_num> ROWS ONLY". Limit which precedes Offset
+// returns an error which wraps ErrLimitOffsetRequired because FETCH clause
+// has to follow OFFSET clause. Use LimitOffset instead.
 type Limit int64
 
 // Write writes the number of limitations that the Limit has.
 func (l Limit) Write(b Builder) error {
-	b.WriteString("LIMIT ")
-	return Numeric(l).Write(b)
+	d := DialectOf(b)
+	if !dialect.CombinedLimitOffset(d) {
+		b.WriteString(d.LimitOffset(int64(l), -1))
+		return nil
+	}
+	p := pagingOf(b)
+	if p&PagingLimit != 0 {
+		return newError("", l, ErrLimitOffsetRequired)
+	}
+	setPaging(b, p|PagingLimit)
+	if p&PagingOffset != 0 {
+		b.WriteString("FETCH NEXT " + strconv.FormatInt(int64(l), 10) + " ROWS ONLY")
+		return nil
+	}
+	b.WriteString(d.LimitOffset(int64(l), -1))
+	return nil
 }
 
 // Offset represents "OFFSET <offset_num>".
+// The syntax is decided by the dialect of the Builder.
+//
+// If the dialect writes the limit and the offset as a single clause, Offset
+// which follows Limit in the same query returns an error which wraps
+// ErrLimitOffsetRequired. See also Limit.
 type Offset int64
 
 // Write writes the number of offsets that the Offset has.
 func (o Offset) Write(b Builder) error {
-	b.WriteString("OFFSET ")
-	return Numeric(o).Write(b)
+	d := DialectOf(b)
+	if !dialect.CombinedLimitOffset(d) {
+		b.WriteString(d.LimitOffset(-1, int64(o)))
+		return nil
+	}
+	p := pagingOf(b)
+	if p != 0 {
+		return newError("", o, ErrLimitOffsetRequired)
+	}
+	setPaging(b, PagingOffset)
+	b.WriteString(d.LimitOffset(-1, int64(o)))
+	return nil
+}
+
+// LimitOffset represents "LIMIT <limit_num> OFFSET <offset_num>".
+// The syntax is decided by the dialect of the Builder. e.g. SQL Server
+// uses "OFFSET <offset_num> ROWS FETCH NEXT <limit_num> ROWS ONLY".
+//
+// You should use this instead of Limit and Offset if the dialect requires
+// the clause to be written at once. See also dialect.CombinedLimitOffset.
+type LimitOffset struct {
+	Limit  int64
+	Offset int64
+}
+
+// Write writes the number of limitations and offsets that the LimitOffset has.
+func (l LimitOffset) Write(b Builder) error {
+	b.WriteString(DialectOf(b).LimitOffset(l.Limit, l.Offset))
+	return nil
 }
 
 // OrderBy represents "<column_name>", "<column_name> DESC".
@@ -1,8 +1,11 @@
 package stmt
 
 import (
+	"errors"
 	"strings"
 	"testing"
+
+	"github.com/Code-Hex/sqb/dialect"
 )
 
 func TestString_Write(t *testing.T) {
@@ -190,3 +193,180 @@ func TestOrderBy_Write(t *testing.T) {
 		})
 	}
 }
+
+func TestIdent_Write(t *testing.T) {
+	tests := []struct {
+		name    string
+		i       Ident
+		dialect dialect.Dialect
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "mysql",
+			i:       Ident("order"),
+			dialect: dialect.MySQL{},
+			want:    "`order`",
+		},
+		{
+			name:    "postgresql",
+			i:       Ident(`my"table`),
+			dialect: dialect.PostgreSQL{},
+			want:    `"my""table"`,
+		},
+		{
+			name:    "invalid",
+			i:       Ident(""),
+			dialect: dialect.MySQL{},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			err := tt.i.Write(b)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Ident.Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got := b.buf.String(); tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLimitOffset_Write_Dialect(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    Expr
+		dialect dialect.Dialect
+		want    string
+	}{
+		{
+			name:    "limit with postgresql",
+			expr:    Limit(10),
+			dialect: dialect.PostgreSQL{},
+			want:    "LIMIT 10",
+		},
+		{
+			name:    "limit and offset with mysql",
+			expr:    LimitOffset{Limit: 10, Offset: 5},
+			dialect: dialect.MySQL{},
+			want:    "LIMIT 10 OFFSET 5",
+		},
+		{
+			name:    "limit and offset with sqlserver",
+			expr:    LimitOffset{Limit: 10, Offset: 5},
+			dialect: dialect.SQLServer{},
+			want:    "OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "offset with sqlite",
+			expr:    Offset(5),
+			dialect: dialect.SQLite{},
+			want:    "LIMIT -1 OFFSET 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &DialectCapture{dialect: tt.dialect}
+			if err := tt.expr.Write(b); err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if got := b.buf.String(); tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestLimitOffset_Write_Combined(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect dialect.Dialect
+		exprs   []Expr
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "limit with sqlserver",
+			dialect: dialect.SQLServer{},
+			exprs:   []Expr{Limit(10)},
+			want:    "OFFSET 0 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "offset with sqlserver",
+			dialect: dialect.SQLServer{},
+			exprs:   []Expr{Offset(5)},
+			want:    "OFFSET 5 ROWS",
+		},
+		{
+			name:    "offset and limit with sqlserver",
+			dialect: dialect.SQLServer{},
+			exprs:   []Expr{Offset(5), Limit(10)},
+			want:    "OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "limit and offset with sqlserver",
+			dialect: dialect.SQLServer{},
+			exprs:   []Expr{Limit(10), Offset(5)},
+			wantErr: true,
+		},
+		{
+			name:    "limit twice with sqlserver",
+			dialect: dialect.SQLServer{},
+			exprs:   []Expr{Offset(5), Limit(10), Limit(10)},
+			wantErr: true,
+		},
+		{
+			name:    "limit with oracle",
+			dialect: dialect.Oracle{},
+			exprs:   []Expr{Limit(10)},
+			want:    "FETCH FIRST 10 ROWS ONLY",
+		},
+		{
+			name:    "offset and limit with oracle",
+			dialect: dialect.Oracle{},
+			exprs:   []Expr{Offset(5), Limit(10)},
+			want:    "OFFSET 5 ROWS FETCH NEXT 10 ROWS ONLY",
+		},
+		{
+			name:    "limit and offset with oracle",
+			dialect: dialect.Oracle{},
+			exprs:   []Expr{Limit(10), Offset(5)},
+			wantErr: true,
+		},
+		{
+			name:    "limit and offset with mysql",
+			dialect: dialect.MySQL{},
+			exprs:   []Expr{Limit(10), Offset(5)},
+			want:    "LIMIT 10 OFFSET 5",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &PagingCapture{DialectCapture: DialectCapture{dialect: tt.dialect}}
+			var err error
+			for i, expr := range tt.exprs {
+				if i > 0 {
+					b.WriteString(" ")
+				}
+				if err = expr.Write(b); err != nil {
+					break
+				}
+			}
+			if tt.wantErr {
+				if !errors.Is(err, ErrLimitOffsetRequired) {
+					t.Fatalf("Write() error = %v, want %v", err, ErrLimitOffsetRequired)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Write() error = %v", err)
+			}
+			if got := b.buf.String(); tt.want != got {
+				t.Errorf("\nwant: %q\ngot: %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package stmt
 
-import "strings"
+import (
+	"strings"
+
+	"github.com/Code-Hex/sqb/dialect"
+)
 
 var _ Builder = (*BuildCapture)(nil)
 
@@ -21,6 +25,23 @@ func (b *BuildCapture) AppendArgs(args ...interface{}) {
 	b.Args = append(b.Args, args...)
 }
 
+var _ DialectBuilder = (*DialectCapture)(nil)
+
+type DialectCapture struct {
+	BuildCapture
+	dialect dialect.Dialect
+	counter int
+}
+
+func (b *DialectCapture) WritePlaceholder() {
+	b.counter++
+	b.dialect.WritePlaceholder(&b.buf, b.counter)
+}
+
+func (b *DialectCapture) Dialect() dialect.Dialect {
+	return b.dialect
+}
+
 var _ Expr = (*ExprMock)(nil)
 
 type ExprMock struct {
@@ -40,3 +61,49 @@ type ComparisonerMock struct {
 func (c *ComparisonerMock) WriteComparison(b Builder) error {
 	return c.WriteComparisonMock(b)
 }
+
+var _ ShapeBuilder = (*ShapeCapture)(nil)
+
+type ShapeCapture struct {
+	BuildCapture
+}
+
+func (b *ShapeCapture) Shape() bool {
+	return true
+}
+
+var _ InBuilder = (*InCapture)(nil)
+
+type InCapture struct {
+	DialectCapture
+	in InOptions
+}
+
+func (b *InCapture) InOptions() InOptions {
+	return b.in
+}
+
+var _ NullBuilder = (*NullCapture)(nil)
+
+type NullCapture struct {
+	DialectCapture
+}
+
+func (b *NullCapture) NilAsNull() bool {
+	return true
+}
+
+var _ PagingBuilder = (*PagingCapture)(nil)
+
+type PagingCapture struct {
+	DialectCapture
+	paging Paging
+}
+
+func (b *PagingCapture) Paging() Paging {
+	return b.paging
+}
+
+func (b *PagingCapture) SetPaging(p Paging) {
+	b.paging = p
+}
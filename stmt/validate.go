@@ -0,0 +1,164 @@
+package stmt
+
+import (
+	"errors"
+	"strconv"
+)
+
+var _ error = (ValidationErrors)(nil)
+
+// ValidationErrors is the error type returned by Validate.
+// It contains all errors found in the expression tree.
+type ValidationErrors []*BuildError
+
+func (v ValidationErrors) Error() string {
+	switch len(v) {
+	case 0:
+		return "no errors"
+	case 1:
+		return v[0].Error()
+	}
+	msg := strconv.Itoa(len(v)) + " errors occurred: " + v[0].Error()
+	for _, err := range v[1:] {
+		msg += "; " + err.Error()
+	}
+	return msg
+}
+
+// Is reports whether any error in v matches target.
+// This method is implemented to use errors.Is.
+func (v ValidationErrors) Is(target error) bool {
+	for _, err := range v {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate walks the whole expression tree and reports every invalid node
+// without writing the query. It returns ValidationErrors if there are
+// invalid nodes, otherwise returns nil.
+//
+// And, Or, Paren, Not, Condition and CompBetween are walked into the children.
+// The other expressions are validated by writing them into a discarded
+// builder, so an error which is returned by Write is reported.
+// The expressions are validated with the default dialect. See also
+// ValidateWith.
+func Validate(expr Expr) error {
+	return ValidateWith(discard{}, expr)
+}
+
+// ValidateWith is like Validate, but the expressions are written into b
+// instead of a discarded builder, so that they are validated with the
+// settings of b such as DialectBuilder, InBuilder and NullBuilder.
+// The contents which are written into b should be discarded.
+func ValidateWith(b Builder, expr Expr) error {
+	v := validator{b: b}
+	v.expr("", expr)
+	if len(v.errs) == 0 {
+		return nil
+	}
+	return v.errs
+}
+
+type validator struct {
+	b    Builder
+	errs ValidationErrors
+}
+
+func (v *validator) add(err *BuildError) {
+	v.errs = append(v.errs, err)
+}
+
+func (v *validator) expr(op string, expr Expr) {
+	switch e := expr.(type) {
+	case nil:
+		v.add(newError(op, expr, ErrNilOperand))
+	case *And:
+		v.binary(joinOp(op, "And"), e, e.Left, e.Right)
+	case *Or:
+		v.binary(joinOp(op, "Or"), e, e.Left, e.Right)
+	case *Paren:
+		if e.Expr == nil {
+			v.add(newError(joinOp(op, "Paren"), e, ErrNilOperand))
+			return
+		}
+		v.expr(joinOp(op, "Paren"), e.Expr)
+	case *Not:
+		if e.Expr == nil {
+			v.add(newError(joinOp(op, "Not"), e, ErrNilOperand))
+			return
+		}
+		v.expr(joinOp(op, "Not"), e.Expr)
+	case *Condition:
+		if e.Compare == nil {
+			v.add(newError(joinOp(op, e.Op()), e, ErrNilOperand))
+			return
+		}
+		if in, ok := e.Compare.(*CompIn); ok {
+			// The IN which is written by the condition such as the
+			// predicate of the empty IN is validated as it is built.
+			if ok, err := e.writeIn(v.b, in); ok {
+				if err != nil {
+					v.add(WrapError(op, e, err))
+				}
+				return
+			}
+		}
+		if err := e.left().Write(v.b); err != nil {
+			v.add(WrapError(joinOp(op, e.Op()+".Left"), e.left(), err))
+		}
+		v.compare(joinOp(op, e.Op()), e.Compare)
+	default:
+		if err := expr.Write(v.b); err != nil {
+			v.add(WrapError(op, expr, err))
+		}
+	}
+}
+
+func (v *validator) binary(op string, node Expr, left, right Expr) {
+	if left == nil {
+		v.add(newError(op+".Left", node, ErrNilOperand))
+	} else {
+		v.expr(op+".Left", left)
+	}
+	if right == nil {
+		v.add(newError(op+".Right", node, ErrNilOperand))
+	} else {
+		v.expr(op+".Right", right)
+	}
+}
+
+func (v *validator) compare(op string, c Comparisoner) {
+	if c, ok := c.(*CompBetween); ok {
+		if c.Left == nil {
+			v.add(newError(joinOp(op, "CompBetween.Left"), c, ErrNilOperand))
+		}
+		if c.Right == nil {
+			v.add(newError(joinOp(op, "CompBetween.Right"), c, ErrNilOperand))
+		}
+		if c.Left == nil || c.Right == nil {
+			return
+		}
+	}
+	if cc, ok := c.(ColumnComparisoner); ok {
+		// The left side is validated by the caller.
+		if err := cc.WriteColumnComparison(v.b, column("")); err != nil {
+			v.add(WrapError(op, c, err))
+		}
+		return
+	}
+	if err := c.WriteComparison(v.b); err != nil {
+		v.add(WrapError(op, c, err))
+	}
+}
+
+var _ Builder = discard{}
+
+// discard is a Builder which discards everything written.
+type discard struct{}
+
+func (discard) WritePlaceholder()         {}
+func (discard) WriteString(string)        {}
+func (discard) AppendArgs(...interface{}) {}
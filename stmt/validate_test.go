@@ -0,0 +1,180 @@
+package stmt
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		expr  Expr
+		want  []string
+		cause error
+	}{
+		{
+			name: "valid",
+			expr: &And{
+				Left: &Condition{
+					Column:  "name",
+					Compare: &CompOp{Op: "=", Value: "taro"},
+				},
+				Right: &Paren{
+					Expr: &Condition{
+						Column:  "category",
+						Compare: &CompIn{Values: []interface{}{1, 2}},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "nil",
+			expr: nil,
+			want: []string{
+				"unset operand",
+			},
+			cause: ErrNilOperand,
+		},
+		{
+			name: "every invalid node",
+			expr: &And{
+				Left: &Or{
+					Left: &Condition{
+						Column:  "category",
+						Compare: &CompIn{},
+					},
+					Right: nil,
+				},
+				Right: &Paren{
+					Expr: &And{
+						Left: &Condition{
+							Column:  "price",
+							Compare: &CompBetween{},
+						},
+						Right: &Condition{
+							Column: "name",
+						},
+					},
+				},
+			},
+			want: []string{
+				"And.Left.Or.Left.Condition(category): it should be passed at least more than 1 (*stmt.CompIn)",
+				"And.Left.Or.Right: unset operand (*stmt.Or)",
+				"And.Right.Paren.And.Left.Condition(price).CompBetween.Left: unset operand (*stmt.CompBetween)",
+				"And.Right.Paren.And.Left.Condition(price).CompBetween.Right: unset operand (*stmt.CompBetween)",
+				"And.Right.Paren.And.Right.Condition(name): unset operand (*stmt.Condition)",
+			},
+			cause: ErrEmptyIn,
+		},
+		{
+			name: "other expressions",
+			expr: &Or{
+				Left: Columns{},
+				Right: &ExprMock{
+					WriteMock: func(Builder) error {
+						return errors.New("error")
+					},
+				},
+			},
+			want: []string{
+				"Or.Left: unspecified columns (stmt.Columns)",
+				"Or.Right: error (*stmt.ExprMock)",
+			},
+			cause: ErrEmptyColumns,
+		},
+		{
+			name: "not",
+			expr: &And{
+				Left: &Not{
+					Expr: &Condition{
+						Column:  "category",
+						Compare: &CompIn{},
+					},
+				},
+				Right: &Not{},
+			},
+			want: []string{
+				"And.Left.Not.Condition(category): it should be passed at least more than 1 (*stmt.CompIn)",
+				"And.Right.Not: unset operand (*stmt.Not)",
+			},
+			cause: ErrEmptyIn,
+		},
+		{
+			name: "other comparisoner",
+			expr: &Condition{
+				Column: "name",
+				Compare: &ComparisonerMock{
+					WriteComparisonMock: func(Builder) error {
+						return errors.New("error")
+					},
+				},
+			},
+			want: []string{
+				"Condition(name): error (*stmt.ComparisonerMock)",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Validate(tt.expr)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Validate() error = %v", err)
+				}
+				return
+			}
+			errs, ok := err.(ValidationErrors)
+			if !ok {
+				t.Fatalf("Validate() error = %T, want ValidationErrors", err)
+			}
+			if len(errs) != len(tt.want) {
+				t.Fatalf("Validate() got %d errors, want %d: %v", len(errs), len(tt.want), errs)
+			}
+			for i, want := range tt.want {
+				if got := errs[i].Error(); got != want {
+					t.Errorf("errs[%d]\ngot = %q\nwant %q", i, got, want)
+				}
+			}
+			if tt.cause != nil && !errors.Is(err, tt.cause) {
+				t.Errorf("errors.Is(%v, %v) = false", err, tt.cause)
+			}
+		})
+	}
+}
+
+func TestValidationErrors_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		v    ValidationErrors
+		want string
+	}{
+		{
+			name: "empty",
+			v:    ValidationErrors{},
+			want: "no errors",
+		},
+		{
+			name: "single",
+			v: ValidationErrors{
+				{Op: "And.Left", Err: ErrNilOperand},
+			},
+			want: "And.Left: unset operand",
+		},
+		{
+			name: "multiple",
+			v: ValidationErrors{
+				{Op: "And.Left", Err: ErrNilOperand},
+				{Op: "And.Right", Err: ErrNilOperand},
+			},
+			want: "2 errors occurred: And.Left: unset operand; And.Right: unset operand",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.Error(); got != tt.want {
+				t.Errorf("ValidationErrors.Error() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
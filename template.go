@@ -0,0 +1,132 @@
+package sqb
+
+import (
+	"strconv"
+
+	"github.com/Code-Hex/sqb/internal/lexer"
+)
+
+// Template represents a compiled base query.
+//
+// Template is immutable. It can be shared across goroutines
+// and used by multiple Builders simultaneously.
+type Template struct {
+	query  string
+	tokens []lexer.Token
+	// number of bindVars '?'
+	bindVars int
+	// number of placeholders '??'
+	placeholders int
+	// unique names of named bindVars "{{name}}"
+	names []string
+}
+
+// Compile parses the base query and returns, if successful, a Template
+// that can be used to build sql query string by Builder.BuildTemplate.
+//
+// The opts are used to parse the base query, e.g. SetPlaceholder decides
+// quoting rules of string literals and SetSlotMarker decides the bindVar.
+func Compile(baseQuery string, opts ...Option) (*Template, error) {
+	b := New(opts...)
+	t := &Template{query: baseQuery}
+	s := lexer.New(baseQuery, b.marker, b.lexerRules())
+	for s.Scan() {
+		tok := s.Token()
+		switch tok.Kind {
+		case lexer.Slot:
+			t.bindVars++
+		case lexer.Placeholder:
+			t.placeholders++
+		case lexer.NamedSlot:
+			if !t.hasName(tok.Name) {
+				t.names = append(t.names, tok.Name)
+			}
+		}
+		t.tokens = append(t.tokens, tok)
+	}
+	if err := s.Err(); err != nil {
+		return nil, queryError(err)
+	}
+	return t, nil
+}
+
+// MustCompile is like Compile but panics if the base query cannot be parsed.
+// It simplifies safe initialization of global variables holding compiled
+// templates.
+func MustCompile(baseQuery string, opts ...Option) *Template {
+	t, err := Compile(baseQuery, opts...)
+	if err != nil {
+		panic("sqb: Compile(" + strconv.Quote(baseQuery) + "): " + err.Error())
+	}
+	return t
+}
+
+// String returns the source text used to compile the template.
+func (t *Template) String() string {
+	return t.query
+}
+
+func (t *Template) hasName(name string) bool {
+	for _, n := range t.names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildTemplate builds sql query string from the compiled template.
+// It behaves like Build, but the base query is not scanned again.
+//
+// The number of bound expressions and args are checked before
+// the expressions are written.
+func (b *Builder) BuildTemplate(t *Template) (string, []interface{}, error) {
+	if err := b.checkTemplate(t); err != nil {
+		return "", nil, err
+	}
+	return b.build(&templateScanner{tokens: t.tokens, i: -1})
+}
+
+// checkTemplate checks whether the bound expressions and args match
+// the bindVars of the template before building.
+func (b *Builder) checkTemplate(t *Template) error {
+	if len(b.stmt) < t.bindVars {
+		return slotError(len(b.stmt), nil, ErrMissingExpr)
+	}
+	if b.strict && len(b.stmt) > t.bindVars {
+		return slotError(t.bindVars, b.stmt[t.bindVars], ErrUnusedExpr)
+	}
+	if len(b.args) < t.placeholders {
+		return placeholderError(len(b.args), ErrMissingArg)
+	}
+	if len(b.args) > t.placeholders {
+		return placeholderError(t.placeholders, ErrUnusedArg)
+	}
+	for _, name := range t.names {
+		if b.lookupNamed(name) == -1 {
+			return namedError(name, nil, ErrMissingName)
+		}
+	}
+	return nil
+}
+
+var _ tokenScanner = (*templateScanner)(nil)
+
+// templateScanner scans tokens of compiled Template.
+type templateScanner struct {
+	tokens []lexer.Token
+	i      int
+}
+
+func (s *templateScanner) Scan() bool {
+	s.i++
+	return s.i < len(s.tokens)
+}
+
+func (s *templateScanner) Token() lexer.Token {
+	return s.tokens[s.i]
+}
+
+func (s *templateScanner) Err() error {
+	return nil
+}
@@ -0,0 +1,178 @@
+package sqb_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/Code-Hex/sqb"
+	"github.com/Code-Hex/sqb/stmt"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name    string
+		sql     string
+		options []sqb.Option
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			sql:     "SELECT * FROM tables WHERE ? AND {{where}} AND id = ??",
+			wantErr: false,
+		},
+		{
+			name: "valid dollar quotes with postgresql",
+			sql:  "SELECT $$what?$$ FROM tables WHERE ?",
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.Dollar),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid unterminated literal",
+			sql:     "SELECT * FROM tables WHERE name = 'taro AND ?",
+			wantErr: true,
+		},
+		{
+			name:    "invalid named bindVar",
+			sql:     "SELECT * FROM tables WHERE {{wh ere}}",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := sqb.Compile(tt.sql, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Compile() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && tmpl.String() != tt.sql {
+				t.Errorf("want %q, but got %q", tt.sql, tmpl.String())
+			}
+		})
+	}
+}
+
+func TestMustCompile(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("MustCompile() did not panic")
+		}
+	}()
+	sqb.MustCompile("SELECT * FROM tables WHERE name = 'taro")
+}
+
+func TestBuilder_BuildTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		tmpl     *sqb.Template
+		options  []sqb.Option
+		stmts    []stmt.Expr
+		named    map[string]stmt.Expr
+		args     []interface{}
+		want     string
+		wantArgs []interface{}
+		wantErr  bool
+	}{
+		{
+			name: "valid",
+			tmpl: sqb.MustCompile("SELECT * FROM tables WHERE ? AND {{where}} AND tenant_id = ?? ?"),
+			options: []sqb.Option{
+				sqb.SetPlaceholder(sqb.Dollar),
+			},
+			stmts: []stmt.Expr{
+				sqb.In("category", 1, 2),
+				sqb.Limit(10),
+			},
+			named: map[string]stmt.Expr{
+				"where": sqb.Eq("name", "taro"),
+			},
+			args:     []interface{}{100},
+			want:     "SELECT * FROM tables WHERE category IN ($1, $2) AND name = $3 AND tenant_id = $4 LIMIT 10",
+			wantArgs: []interface{}{1, 2, "taro", 100},
+			wantErr:  false,
+		},
+		{
+			name: "invalid bindVars exceeds replaceable statements",
+			tmpl: sqb.MustCompile("SELECT * FROM tables WHERE ? AND ?"),
+			stmts: []stmt.Expr{
+				sqb.Eq("name", "taro"),
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid placeholders exceeds bound args",
+			tmpl:     sqb.MustCompile("SELECT * FROM tables WHERE id = ??"),
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name:     "invalid missing named bindVar",
+			tmpl:     sqb.MustCompile("SELECT * FROM tables WHERE {{where}}"),
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+		{
+			name: "invalid unknown named bindVar",
+			tmpl: sqb.MustCompile("SELECT * FROM tables WHERE {{where}}"),
+			named: map[string]stmt.Expr{
+				"where": sqb.Eq("name", "taro"),
+				"order": sqb.OrderBy("id", false),
+			},
+			want:     "",
+			wantArgs: nil,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := sqb.New(tt.options...)
+			for _, expr := range tt.stmts {
+				b = b.Bind(expr)
+			}
+			for name, expr := range tt.named {
+				b = b.BindNamed(name, expr)
+			}
+			got, args, err := b.BindArgs(tt.args...).BuildTemplate(tt.tmpl)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Builder.BuildTemplate() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if got != tt.want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantArgs, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBuilder_BuildTemplate_Concurrent(t *testing.T) {
+	tmpl := sqb.MustCompile("SELECT * FROM tables WHERE ? AND name = 'what?'")
+	const want = "SELECT * FROM tables WHERE category = ? AND name = 'what?'"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			got, args, err := sqb.New().Bind(sqb.Eq("category", i)).BuildTemplate(tmpl)
+			if err != nil {
+				t.Errorf("Builder.BuildTemplate() error = %v", err)
+				return
+			}
+			if got != want {
+				t.Errorf("sql\ngot = %q\nwant %q", got, want)
+			}
+			if diff := cmp.Diff([]interface{}{i}, args); diff != "" {
+				t.Errorf("args (-want, +got)\n%s", diff)
+			}
+		}(i)
+	}
+	wg.Wait()
+}